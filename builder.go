@@ -0,0 +1,193 @@
+package gocron
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Builder is a fluent builder of schedules from typed values as an alternative
+// to writing a Cron expression. A field without values matches every value.
+//
+// The builder is immutable and each method returns a copy.
+type Builder struct {
+	seconds  []int
+	minutes  []int
+	hours    []int
+	days     []int
+	months   []int
+	weekdays []int
+	years    []int
+}
+
+// Every returns a builder of a schedule which activates every second until it
+// is restricted.
+func Every() Builder {
+	return Builder{}
+}
+
+// At returns a copy of the builder which activates at the given time of the
+// day.
+func (b Builder) At(hour, minute, second int) Builder {
+	b.hours = []int{hour}
+	b.minutes = []int{minute}
+	b.seconds = []int{second}
+	return b
+}
+
+// Seconds returns a copy of the builder which activates at the given seconds.
+func (b Builder) Seconds(seconds ...int) Builder {
+	b.seconds = slices.Clone(seconds)
+	return b
+}
+
+// Minutes returns a copy of the builder which activates at the given minutes.
+func (b Builder) Minutes(minutes ...int) Builder {
+	b.minutes = slices.Clone(minutes)
+	return b
+}
+
+// Hours returns a copy of the builder which activates at the given hours.
+func (b Builder) Hours(hours ...int) Builder {
+	b.hours = slices.Clone(hours)
+	return b
+}
+
+// OnDays returns a copy of the builder which activates on the given days of
+// the month.
+func (b Builder) OnDays(days ...int) Builder {
+	b.days = slices.Clone(days)
+	return b
+}
+
+// Weekday returns a copy of the builder which activates on the given week
+// days.
+func (b Builder) Weekday(weekdays ...time.Weekday) Builder {
+	b.weekdays = make([]int, len(weekdays))
+	for i, weekday := range weekdays {
+		b.weekdays[i] = int(weekday)
+	}
+	return b
+}
+
+// InMonths returns a copy of the builder which activates in the given months.
+func (b Builder) InMonths(months ...time.Month) Builder {
+	b.months = make([]int, len(months))
+	for i, month := range months {
+		b.months[i] = int(month)
+	}
+	return b
+}
+
+// InYears returns a copy of the builder which activates in the given years.
+func (b Builder) InYears(years ...int) Builder {
+	b.years = slices.Clone(years)
+	return b
+}
+
+// Build returns the schedule of the builder, or an error if a value is outside
+// the range of its field.
+func (b Builder) Build() (schedule Schedule, err error) {
+	fields := []struct {
+		kind     TimeUnitKind
+		values   []int
+		min, max int
+	}{
+		{Seconds, b.seconds, rangeMinSecond, rangeMaxSecond},
+		{Minutes, b.minutes, rangeMinMinute, rangeMaxMinute},
+		{Hours, b.hours, rangeMinHour, rangeMaxHour},
+		{Days, b.days, rangeMinDayOfMonth, rangeMaxDayOfMonth},
+		{Months, b.months, rangeMinMonth, rangeMaxMonth},
+		{WeekDays, b.weekdays, rangeMinWeekday, rangeMaxWeekday},
+		{Years, b.years, rangeMinYear, rangeMaxYear},
+	}
+
+	sets := make([][]timeSet, len(fields))
+	for i, field := range fields {
+		for _, value := range field.values {
+			set := unitExpr(value)
+			if !set.SubsetOf(field.min, field.max) {
+				return schedule, newTimeUnitErr(field.kind, ErrValueOutsideRange)
+			}
+
+			sets[i] = append(sets[i], set)
+		}
+	}
+
+	schedule.timeUnits = []TimeUnit{
+		yearTimeUnit(sets[6]),
+		monthTimeUnit(sets[4]),
+		dayTimeUnit(sets[3]),
+		weekdayTimeUnit(sets[5]),
+		hourTimeUnit(sets[2]),
+		minTimeUnit(sets[1]),
+		secTimeUnit(sets[0]),
+	}
+
+	return schedule, nil
+}
+
+// MustBuild returns the schedule of the builder and panics in case of error.
+func (b Builder) MustBuild() Schedule {
+	schedule, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return schedule
+}
+
+// String returns the Cron expression equivalent to the builder.
+func (b Builder) String() string {
+	days, weekdays := formatBuilderField(b.days), formatBuilderWeekdays(b.weekdays)
+	switch {
+	case b.weekdays == nil:
+		weekdays = "?"
+	case b.days == nil:
+		days = "?"
+	}
+
+	fields := []string{
+		formatBuilderField(b.seconds),
+		formatBuilderField(b.minutes),
+		formatBuilderField(b.hours),
+		days,
+		formatBuilderField(b.months),
+		weekdays,
+	}
+	if b.years != nil {
+		fields = append(fields, formatBuilderField(b.years))
+	}
+
+	return strings.Join(fields, " ")
+}
+
+func formatBuilderField(values []int) string {
+	if len(values) == 0 {
+		return "*"
+	}
+
+	items := make([]string, len(values))
+	for i, value := range values {
+		items[i] = strconv.Itoa(value)
+	}
+	return strings.Join(items, ",")
+}
+
+func formatBuilderWeekdays(values []int) string {
+	if len(values) == 0 {
+		return "*"
+	}
+
+	items := make([]string, len(values))
+	for i, value := range values {
+		if value < rangeMinWeekday || value > rangeMaxWeekday {
+			// Keep the value so that parsing the expression fails the same
+			// way as building the schedule.
+			items[i] = strconv.Itoa(value)
+			continue
+		}
+		items[i] = strings.ToUpper(time.Weekday(value).String()[:3])
+	}
+	return strings.Join(items, ",")
+}
@@ -0,0 +1,84 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	schedule, err := Every().Weekday(time.Monday, time.Friday).At(9, 30, 0).InMonths(time.January).Build()
+	requireNoError(t, err)
+
+	iter := schedule.Upcoming(time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2024-01-01 09:30:00 +0000 UTC",
+		"2024-01-05 09:30:00 +0000 UTC",
+		"2024-01-08 09:30:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestBuilder_String(t *testing.T) {
+	vectors := []struct {
+		builder Builder
+		expect  string
+	}{
+		{Every(), "* * * * * ?"},
+		{Every().At(9, 30, 0), "0 30 9 * * ?"},
+		{Every().Weekday(time.Monday, time.Friday).At(9, 30, 0).InMonths(time.January), "0 30 9 ? 1 MON,FRI"},
+		{Every().Seconds(0).Minutes(0, 30).OnDays(1, 15).InYears(2024), "0 0,30 * 1,15 * ? 2024"},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.expect, func(t *testing.T) {
+			if v.builder.String() != v.expect {
+				t.Fatalf("%q != %q", v.builder.String(), v.expect)
+			}
+
+			// The expression must produce the same schedule as the builder.
+			from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+			a := v.builder.MustBuild().Upcoming(from)
+			b := MustParse(v.expect).Upcoming(from)
+			for i := 0; i < 10; i++ {
+				if next := a.Next(); !next.Equal(b.Next()) {
+					t.Fatalf("activation %v differs", next)
+				}
+			}
+		})
+	}
+}
+
+func TestBuilder_Build_abortsOnValuesOutsideRange(t *testing.T) {
+	vectors := []struct {
+		builder Builder
+		kind    TimeUnitKind
+	}{
+		{Every().At(24, 0, 0), Hours},
+		{Every().At(0, 60, 0), Minutes},
+		{Every().Seconds(60), Seconds},
+		{Every().OnDays(32), Days},
+		{Every().InMonths(13), Months},
+		{Every().Weekday(7), WeekDays},
+		{Every().InYears(0), Years},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.builder.String(), func(t *testing.T) {
+			_, err := v.builder.Build()
+			requireErrorIs(t, err, newTimeUnitErr(v.kind, ErrValueOutsideRange))
+
+			_, err = Parse(v.builder.String())
+			requireErrorIs(t, err, newTimeUnitErr(v.kind, ErrValueOutsideRange))
+		})
+	}
+}
+
+func TestBuilder_isImmutable(t *testing.T) {
+	base := Every().At(9, 0, 0)
+	_ = base.Weekday(time.Monday)
+
+	if base.String() != "0 0 9 * * ?" {
+		t.Fatalf("unexpected expression %q", base.String())
+	}
+}
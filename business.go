@@ -0,0 +1,105 @@
+package gocron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BusinessCalendar determines the business days used by the `B` character of
+// the days field.
+type BusinessCalendar interface {
+	// IsBusinessDay returns true when the day of the given time is a business
+	// day.
+	IsBusinessDay(day time.Time) bool
+}
+
+// weekdayCalendar is the default business calendar where every week day from
+// Monday to Friday is a business day.
+type weekdayCalendar struct{}
+
+// IsBusinessDay implements BusinessCalendar.
+func (weekdayCalendar) IsBusinessDay(day time.Time) bool {
+	return day.Weekday() != time.Saturday && day.Weekday() != time.Sunday
+}
+
+// IsBusinessDay implements BusinessCalendar. A business day is a week day from
+// Monday to Friday that does not start during a holiday.
+func (c *HolidayCalendar) IsBusinessDay(day time.Time) bool {
+	return weekdayCalendar{}.IsBusinessDay(day) && !c.Contains(setDays(day, day.Day()))
+}
+
+// WithBusinessCalendar returns an option to set the calendar used to determine
+// the business days of the `B` character. Every week day from Monday to Friday
+// is a business day by default.
+func WithBusinessCalendar(cal BusinessCalendar) ParserOption {
+	return func(p *Parser) {
+		p.businessCal = cal
+	}
+}
+
+// convertDay returns the day of the month which supports the nth business day
+// (e.g. `3B`) or the nth last business day (e.g. `LB` or `LB-1`) in addition to
+// the last day of the month.
+func (p Parser) convertDay(value string) (timeSet, error) {
+	cal := p.businessCal
+	if cal == nil {
+		cal = weekdayCalendar{}
+	}
+
+	if value == "LB" {
+		return businessDayExpr{fromEnd: true, cal: cal}, nil
+	}
+	if offset, found := strings.CutPrefix(value, "LB-"); found {
+		nth, err := strconv.Atoi(offset)
+		return businessDayExpr{nth: nth, fromEnd: true, cal: cal}, err
+	}
+	if prefix, found := strings.CutSuffix(value, "B"); found {
+		nth, err := strconv.Atoi(prefix)
+		return businessDayExpr{nth: nth, cal: cal}, err
+	}
+	return convertWithLastDayOfMonth(value)
+}
+
+// businessDayExpr is a specialized expression field to determine the nth
+// business day of the month, or the nth last when counting from the end of the
+// month where zero is the last business day.
+type businessDayExpr struct {
+	nth     int
+	fromEnd bool
+	cal     BusinessCalendar
+}
+
+func (e businessDayExpr) NearestCandidate(t time.Time, _ int, forwards bool) (int, result) {
+	value := e.valueFor(t)
+	_, direction := unitExpr(value).NearestCandidate(t, t.Day(), forwards)
+	return value, direction
+}
+
+func (e businessDayExpr) valueFor(t time.Time) int {
+	lastDayOfMonth := findLastDayOfMonth(t).Day()
+
+	var days []int
+	for day := 1; day <= lastDayOfMonth; day++ {
+		if e.cal.IsBusinessDay(time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location())) {
+			days = append(days, day)
+		}
+	}
+
+	index := e.nth - 1
+	if e.fromEnd {
+		index = len(days) - 1 - e.nth
+	}
+	if index < 0 || index >= len(days) {
+		// The business day does not exist in this month.
+		return rangeMaxDayOfMonth + 1
+	}
+	return days[index]
+}
+
+func (e businessDayExpr) SubsetOf(min, max int) bool {
+	if e.fromEnd {
+		return e.nth >= 0 && e.nth < max
+	}
+	return e.nth >= min && e.nth <= max
+}
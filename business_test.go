@@ -0,0 +1,66 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule_Upcoming_returnsNthBusinessDay(t *testing.T) {
+	iter := MustParse("0 0 9 3B * ?").Upcoming(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-01-04 09:00:00 +0000 UTC",
+		"2023-02-03 09:00:00 +0000 UTC",
+		"2023-03-03 09:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Preceding_returnsNthLastBusinessDay(t *testing.T) {
+	iter := MustParse("0 0 9 LB-1 * ?").Preceding(time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-02-27 09:00:00 +0000 UTC",
+		"2023-01-30 09:00:00 +0000 UTC",
+		"2022-12-29 09:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Upcoming_returnsBusinessDayOfCalendar(t *testing.T) {
+	cal, err := LoadICalendar("testdata/holidays.ics")
+	requireNoError(t, err)
+
+	parser := NewParser(WithBusinessCalendar(cal))
+
+	schedule, err := parser.Parse("0 0 9 10B * ?")
+	requireNoError(t, err)
+
+	iter := schedule.Upcoming(time.Date(2023, time.August, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-08-21 09:00:00 +0000 UTC",
+		"2023-09-14 09:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+
+	schedule, err = parser.Parse("0 0 9 LB * ?")
+	requireNoError(t, err)
+
+	iter = schedule.Preceding(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	expects = []string{
+		"2023-12-29 09:00:00 +0000 UTC",
+		"2023-11-30 09:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestParser_Parse_abortsOnBusinessDayOutsideRange(t *testing.T) {
+	for _, v := range []string{"0 0 0 0B * ?", "0 0 0 32B * ?", "0 0 0 LB-31 * ?"} {
+		t.Run(v, func(t *testing.T) {
+			_, err := Parse(v)
+			requireErrorIs(t, err, ErrValueOutsideRange)
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package gocron
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time and timers so that the code depending on
+// them can be tested without waiting.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTimer returns a timer which sends the current time on its channel
+	// after the duration.
+	NewTimer(d time.Duration) Timer
+
+	// AfterFunc returns a timer which calls the function in its own goroutine
+	// after the duration. The channel of the timer is nil.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a timer created by a clock which behaves like time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is sent when the timer fires.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing and returns false if the timer has
+	// already fired or been stopped.
+	Stop() bool
+
+	// Reset changes the timer to fire after the duration and returns true if
+	// the timer was active.
+	Reset(d time.Duration) bool
+}
+
+// RealClock returns the clock using the functions of the time package.
+func RealClock() Clock {
+	return realClock{}
+}
+
+// realClock is a clock using the functions of the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{Timer: time.NewTimer(d)}
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return realTimer{Timer: time.AfterFunc(d, f)}
+}
+
+// realTimer is a timer of the time package.
+type realTimer struct {
+	*time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+// FakeClock is a clock which only moves when advanced, and fires the timers
+// which are due in the order of their deadline. The functions of AfterFunc are
+// called synchronously by Advance so that their effects are deterministic. It
+// is safe for concurrent use.
+type FakeClock struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	now    time.Time
+	timers []*fakeTimer
+}
+
+// NewFakeClock returns a fake clock starting at the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	c := &FakeClock{now: now}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now implements Clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// NewTimer implements Clock.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	t := &fakeTimer{clock: c, c: make(chan time.Time, 1)}
+	t.Reset(d)
+	return t
+}
+
+// AfterFunc implements Clock.
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	t := &fakeTimer{clock: c, fn: f}
+	t.Reset(d)
+	return t
+}
+
+// Advance moves the clock forwards by the duration and fires the timers which
+// are due in the order of their deadline. The clock is set to the deadline of
+// each timer when it fires.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	end := c.now.Add(d)
+	c.mu.Unlock()
+
+	for {
+		c.mu.Lock()
+		if len(c.timers) == 0 || c.timers[0].deadline.After(end) {
+			c.now = end
+			c.mu.Unlock()
+			return
+		}
+
+		t := c.timers[0]
+		c.timers = c.timers[1:]
+		c.now = t.deadline
+		c.mu.Unlock()
+
+		t.fire(t.deadline)
+	}
+}
+
+// BlockUntil blocks until at least n timers are waiting to fire, which is
+// useful to know when the code under test has armed its timers.
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
+
+// schedule adds the timer in the order of the deadlines, or fires it when it
+// is already due.
+func (c *FakeClock) schedule(t *fakeTimer, d time.Duration) {
+	c.mu.Lock()
+	t.deadline = c.now.Add(d)
+	if d <= 0 {
+		now := c.now
+		c.mu.Unlock()
+		t.fire(now)
+		return
+	}
+
+	// Timers with the same deadline fire in the order of their creation.
+	i, _ := slices.BinarySearchFunc(c.timers, t.deadline, func(other *fakeTimer, deadline time.Time) int {
+		if other.deadline.After(deadline) {
+			return 1
+		}
+		return -1
+	})
+	c.timers = slices.Insert(c.timers, i, t)
+	c.cond.Broadcast()
+	c.mu.Unlock()
+}
+
+// unschedule removes the timer and returns true if it was waiting to fire.
+func (c *FakeClock) unschedule(t *fakeTimer) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i := slices.Index(c.timers, t)
+	if i < 0 {
+		return false
+	}
+	c.timers = slices.Delete(c.timers, i, i+1)
+	return true
+}
+
+// fakeTimer is a timer of a fake clock.
+type fakeTimer struct {
+	clock    *FakeClock
+	c        chan time.Time
+	fn       func()
+	deadline time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	return t.clock.unschedule(t)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	active := t.clock.unschedule(t)
+	t.clock.schedule(t, d)
+	return active
+}
+
+func (t *fakeTimer) fire(now time.Time) {
+	if t.fn != nil {
+		t.fn()
+		return
+	}
+
+	// Like time.Timer, the time is dropped if the previous one is not
+	// received yet.
+	select {
+	case t.c <- now:
+	default:
+	}
+}
@@ -0,0 +1,98 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock_Advance(t *testing.T) {
+	start := time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	fired := make(chan time.Time, 3)
+	for _, d := range []time.Duration{3 * time.Second, time.Second, 2 * time.Second} {
+		clock.AfterFunc(d, func() { fired <- clock.Now() })
+	}
+
+	timer := clock.NewTimer(90 * time.Second)
+
+	clock.BlockUntil(4)
+	clock.Advance(2 * time.Second)
+
+	requireRun(t, fired, start.Add(time.Second))
+	requireRun(t, fired, start.Add(2*time.Second))
+	requireNoRun(t, fired)
+
+	if !clock.Now().Equal(start.Add(2 * time.Second)) {
+		t.Fatalf("unexpected time: %v", clock.Now())
+	}
+
+	clock.Advance(time.Minute)
+	requireRun(t, fired, start.Add(3*time.Second))
+	requireNoRun(t, timer.C())
+
+	clock.Advance(time.Minute)
+	requireRun(t, timer.C(), start.Add(90*time.Second))
+
+	if !clock.Now().Equal(start.Add(122 * time.Second)) {
+		t.Fatalf("unexpected time: %v", clock.Now())
+	}
+}
+
+func TestFakeClock_NewTimer_keepsDeadlines(t *testing.T) {
+	start := time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	durations := []time.Duration{time.Minute, time.Second, time.Minute}
+
+	timers := make([]Timer, len(durations))
+	for i, d := range durations {
+		timers[i] = clock.NewTimer(d)
+	}
+
+	clock.Advance(time.Hour)
+
+	for i, d := range durations {
+		requireRun(t, timers[i].C(), start.Add(d))
+	}
+}
+
+func TestFakeClock_Stop(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+
+	timer := clock.NewTimer(time.Second)
+	if !timer.Stop() || timer.Stop() {
+		t.Fatal("expected the timer to be stopped once")
+	}
+
+	clock.Advance(time.Minute)
+	requireNoRun(t, timer.C())
+
+	if timer.Reset(time.Second) {
+		t.Fatal("expected the timer to be inactive")
+	}
+
+	clock.Advance(time.Second)
+	requireRun(t, timer.C(), time.Date(2023, time.June, 4, 10, 31, 1, 0, time.UTC))
+}
+
+func TestFakeClock_NewTimer_firesImmediately(t *testing.T) {
+	now := time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC)
+	clock := NewFakeClock(now)
+
+	requireRun(t, clock.NewTimer(0).C(), now)
+	requireRun(t, clock.NewTimer(-time.Second).C(), now)
+}
+
+func TestRealClock(t *testing.T) {
+	clock := RealClock()
+
+	timer := clock.NewTimer(time.Millisecond)
+	if at := <-timer.C(); at.Before(clock.Now().Add(-time.Minute)) {
+		t.Fatalf("unexpected time: %v", at)
+	}
+
+	done := make(chan struct{})
+	clock.AfterFunc(time.Millisecond, func() { close(done) })
+	<-done
+}
@@ -0,0 +1,208 @@
+package gocron
+
+import (
+	"slices"
+	"time"
+)
+
+// Intersect returns a schedule which activates at the times when both
+// schedules activate (e.g. every five minutes during business hours).
+func Intersect(a, b Schedule) Schedule {
+	return Schedule{timeUnits: []TimeUnit{scheduleTimeUnit{schedule: a}, scheduleTimeUnit{schedule: b}}}
+}
+
+// Difference returns a schedule which activates at the times when the first
+// schedule activates, but not the second one.
+func Difference(a, b Schedule) Schedule {
+	return Schedule{timeUnits: append(slices.Clone(a.timeUnits), notTimeUnit{schedule: b})}
+}
+
+// scheduleTimeUnit is a time unit implementation that accepts the activation
+// times of a schedule. As each unit of a schedule jumps to its next candidate,
+// combining them leapfrogs between the schedules instead of testing every
+// second.
+type scheduleTimeUnit struct {
+	schedule Schedule
+}
+
+// Next implements TimeUnit.
+func (u scheduleTimeUnit) Next(next time.Time) (time.Time, bool) {
+	candidate := u.schedule.Next(next.Add(-time.Second))
+	return candidate, candidate.Equal(next)
+}
+
+// Previous implements TimeUnit.
+func (u scheduleTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	candidate := u.schedule.Previous(before.Add(time.Second))
+	return candidate, candidate.Equal(before)
+}
+
+// notTimeUnit is a time unit implementation that rejects the activation times
+// of a schedule. A run of consecutive activations (e.g. a whole weekend) is
+// skipped at once when the time units of the schedule can tell where it ends.
+type notTimeUnit struct {
+	schedule Schedule
+}
+
+// Next implements TimeUnit.
+func (u notTimeUnit) Next(next time.Time) (time.Time, bool) {
+	if !u.schedule.Next(next.Add(-time.Second)).Equal(next) {
+		return next, true
+	}
+
+	end, ok := u.schedule.runEnd(next, true)
+	if !ok {
+		// Every following time is an activation of the schedule.
+		return time.Time{}, false
+	}
+	return end.In(next.Location()), false
+}
+
+// Previous implements TimeUnit.
+func (u notTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	if !u.schedule.Previous(before.Add(time.Second)).Equal(before) {
+		return before, true
+	}
+
+	end, ok := u.schedule.runEnd(before, false)
+	if !ok {
+		return time.Time{}, false
+	}
+	return end.In(before.Location()), false
+}
+
+// runTimeUnit is implemented by the time units which can tell how far the
+// times around an accepted time are accepted too.
+type runTimeUnit interface {
+	// runEnd returns the first time after an accepted time which might not be
+	// accepted, or the last one before it when iterating backwards. It returns
+	// false when every time in that direction is accepted.
+	runEnd(t time.Time, forwards bool) (time.Time, bool)
+}
+
+// runEnd returns the first time after an activation which might not be an
+// activation, or the last one before it when iterating backwards. The next
+// second is returned when a time unit cannot tell, and false when every time in
+// that direction is an activation.
+func (s Schedule) runEnd(t time.Time, forwards bool) (end time.Time, found bool) {
+	for _, unit := range s.timeUnits {
+		switch u := unit.(type) {
+		case locationTimeUnit:
+			t = t.In(u.loc)
+		case runTimeUnit:
+			unitEnd, ok := u.runEnd(t, forwards)
+			if ok && (!found || (forwards && unitEnd.Before(end)) || (!forwards && unitEnd.After(end))) {
+				end, found = unitEnd, true
+			}
+		default:
+			if forwards {
+				return t.Add(time.Second), true
+			}
+			return t.Add(-time.Second), true
+		}
+	}
+	return
+}
+
+// blockFunc returns the beginning of the block of time (e.g. a minute or a
+// day) at an offset from the block of the given time.
+type blockFunc func(t time.Time, offset int) time.Time
+
+// fieldRunEnd returns the end of the run of blocks accepted by a time unit from
+// the block of the time, looking at `limit` blocks at most. When they are all
+// accepted, the unit accepts every time if the field is cyclic (e.g. the 60
+// seconds of a minute), otherwise the run ends after the last block.
+func fieldRunEnd(u TimeUnit, t time.Time, forwards bool, block blockFunc, limit int, cyclic bool) (time.Time, bool) {
+	step := 1
+	if !forwards {
+		step = -1
+	}
+
+	n := 1
+	for ; n <= limit; n++ {
+		start := block(t, n*step)
+		if candidate, ok := u.Next(start); !ok || !candidate.Equal(start) {
+			break
+		}
+	}
+
+	switch {
+	case n > limit && cyclic:
+		return time.Time{}, false
+	case forwards:
+		return block(t, n), true
+	default:
+		// The last second of the block which ends the run.
+		return block(t, 1-n).Add(-time.Second), true
+	}
+}
+
+func secondBlock(t time.Time, offset int) time.Time {
+	return setSeconds(t, t.Second()+offset)
+}
+
+func minuteBlock(t time.Time, offset int) time.Time {
+	return setMinutes(t, t.Minute()+offset)
+}
+
+func hourBlock(t time.Time, offset int) time.Time {
+	return setHours(t, t.Hour()+offset)
+}
+
+func dayBlock(t time.Time, offset int) time.Time {
+	return setDays(t, t.Day()+offset)
+}
+
+func monthBlock(t time.Time, offset int) time.Time {
+	return setMonths(t, t.Month()+time.Month(offset))
+}
+
+func yearBlock(t time.Time, offset int) time.Time {
+	return setYears(t, t.Year()+offset)
+}
+
+// runEnd implements runTimeUnit.
+func (u secTimeUnit) runEnd(t time.Time, forwards bool) (time.Time, bool) {
+	return fieldRunEnd(u, t, forwards, secondBlock, 60, true)
+}
+
+// runEnd implements runTimeUnit.
+func (u minTimeUnit) runEnd(t time.Time, forwards bool) (time.Time, bool) {
+	return fieldRunEnd(u, t, forwards, minuteBlock, 60, true)
+}
+
+// runEnd implements runTimeUnit.
+func (u hourTimeUnit) runEnd(t time.Time, forwards bool) (time.Time, bool) {
+	return fieldRunEnd(u, t, forwards, hourBlock, 24, true)
+}
+
+// runEnd implements runTimeUnit. The days accepted depend on the month, so a
+// run is only followed over two months.
+func (u dayTimeUnit) runEnd(t time.Time, forwards bool) (time.Time, bool) {
+	return fieldRunEnd(u, t, forwards, dayBlock, 62, len(u) == 0)
+}
+
+// runEnd implements runTimeUnit.
+func (u weekdayTimeUnit) runEnd(t time.Time, forwards bool) (time.Time, bool) {
+	return fieldRunEnd(u, t, forwards, dayBlock, 62, len(u) == 0)
+}
+
+// runEnd implements runTimeUnit.
+func (u monthTimeUnit) runEnd(t time.Time, forwards bool) (time.Time, bool) {
+	return fieldRunEnd(u, t, forwards, monthBlock, 12, false)
+}
+
+// runEnd implements runTimeUnit.
+func (u yearTimeUnit) runEnd(t time.Time, forwards bool) (time.Time, bool) {
+	return fieldRunEnd(u, t, forwards, yearBlock, 10, false)
+}
+
+// runEnd implements runTimeUnit.
+func (u weekOfYearTimeUnit) runEnd(t time.Time, forwards bool) (time.Time, bool) {
+	return fieldRunEnd(u, t, forwards, dayBlock, 62, len(u) == 0)
+}
+
+// runEnd implements runTimeUnit.
+func (u dayOfYearTimeUnit) runEnd(t time.Time, forwards bool) (time.Time, bool) {
+	return fieldRunEnd(u, t, forwards, dayBlock, 62, len(u) == 0)
+}
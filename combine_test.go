@@ -0,0 +1,111 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIntersect_returnsCommonActivations(t *testing.T) {
+	schedule := Intersect(MustParse("0 */5 * * * ?"), MustParse("* * 9-16 ? * MON-FRI"))
+
+	iter := schedule.Upcoming(time.Date(2023, time.June, 2, 16, 50, 0, 0, time.UTC))
+	expects := []string{
+		"2023-06-02 16:55:00 +0000 UTC",
+		"2023-06-05 09:00:00 +0000 UTC",
+		"2023-06-05 09:05:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestIntersect_returnsCommonActivationsBackwards(t *testing.T) {
+	schedule := Intersect(MustParse("0 */5 * * * ?"), MustParse("* * 9-16 ? * MON-FRI"))
+
+	iter := schedule.Preceding(time.Date(2023, time.June, 5, 9, 5, 0, 0, time.UTC))
+	expects := []string{
+		"2023-06-05 09:00:00 +0000 UTC",
+		"2023-06-02 16:55:00 +0000 UTC",
+		"2023-06-02 16:50:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestIntersect_returnsZeroWhenDisjoint(t *testing.T) {
+	schedule := Intersect(MustParse("0 0 0 1 * ? 2023"), MustParse("0 0 0 2 * ?"))
+
+	if next := schedule.Next(time.Date(2023, time.June, 2, 0, 0, 0, 0, time.UTC)); !next.IsZero() {
+		t.Fatalf("unexpected activation %v", next)
+	}
+}
+
+func TestDifference_skipsActivations(t *testing.T) {
+	schedule := Difference(MustParse("0 0 12 * * ?"), MustParse("* * * ? * SAT,SUN"))
+
+	iter := schedule.Upcoming(time.Date(2023, time.June, 2, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-06-02 12:00:00 +0000 UTC",
+		"2023-06-05 12:00:00 +0000 UTC",
+		"2023-06-06 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestDifference_skipsActivationsBackwards(t *testing.T) {
+	schedule := Difference(MustParse("0 0 12 * * ?"), MustParse("0 0 12 ? * SAT,SUN"))
+
+	iter := schedule.Preceding(time.Date(2023, time.June, 6, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-06-05 12:00:00 +0000 UTC",
+		"2023-06-02 12:00:00 +0000 UTC",
+		"2023-06-01 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestDifference_skipsRunsOfActivations(t *testing.T) {
+	schedule := Difference(MustParse("* * * * * ?"), MustParse("* * * ? * SAT,SUN"))
+
+	iter := schedule.Upcoming(time.Date(2023, time.June, 2, 23, 59, 58, 0, time.UTC))
+	expects := []string{
+		"2023-06-02 23:59:59 +0000 UTC",
+		"2023-06-05 00:00:00 +0000 UTC",
+		"2023-06-05 00:00:01 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+
+	iter = schedule.Preceding(time.Date(2023, time.June, 5, 0, 0, 1, 0, time.UTC))
+	expects = []string{
+		"2023-06-05 00:00:00 +0000 UTC",
+		"2023-06-02 23:59:59 +0000 UTC",
+		"2023-06-02 23:59:58 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestDifference_skipsLongRunsOfActivations(t *testing.T) {
+	// Testing every second would take millions of iterations to skip half of a
+	// year.
+	schedule := Difference(MustParse("* * * * * ?"), MustParse("* * * * 1-6 ?"))
+
+	next := schedule.Next(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if expect := time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC); !next.Equal(expect) {
+		t.Fatalf("%v != %v", next, expect)
+	}
+
+	prev := schedule.Previous(time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC))
+	if expect := time.Date(2023, time.December, 31, 23, 59, 59, 0, time.UTC); !prev.Equal(expect) {
+		t.Fatalf("%v != %v", prev, expect)
+	}
+}
+
+func TestDifference_followsTimeUnitContract(t *testing.T) {
+	unit := notTimeUnit{schedule: MustParse("* 0-29 9-16 ? * MON-FRI")}
+
+	from := time.Date(2023, time.June, 2, 15, 0, 0, 0, time.UTC)
+	requireNoError(t, VerifyTimeUnit(unit, from, from.AddDate(0, 0, 1)))
+}
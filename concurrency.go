@@ -0,0 +1,37 @@
+package gocron
+
+// ConcurrencyPolicy defines how a job handles an activation while a previous
+// run is still in progress.
+type ConcurrencyPolicy int
+
+const (
+	// ConcurrencyAllow starts the new run alongside the runs in progress.
+	ConcurrencyAllow ConcurrencyPolicy = iota
+	// ConcurrencyForbid skips the new run while a run is in progress.
+	ConcurrencyForbid
+	// ConcurrencyReplace cancels the context of the run in progress and starts
+	// the new run.
+	ConcurrencyReplace
+)
+
+// JobStats is the statistics of the runs of a job.
+type JobStats struct {
+	// Runs is the number of runs started.
+	Runs uint64
+	// Skipped is the number of runs skipped because of ConcurrencyForbid.
+	Skipped uint64
+	// Replaced is the number of runs canceled because of ConcurrencyReplace.
+	Replaced uint64
+	// Retries is the number of attempts made after a failed attempt.
+	Retries uint64
+	// TimedOut is the number of runs which exceeded their deadline.
+	TimedOut uint64
+}
+
+// WithConcurrencyPolicy returns an option to set the concurrency policy of a
+// job, which is ConcurrencyAllow by default.
+func WithConcurrencyPolicy(policy ConcurrencyPolicy) JobOption {
+	return func(e *entry) {
+		e.concurrency = policy
+	}
+}
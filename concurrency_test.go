@@ -0,0 +1,152 @@
+package gocron
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestScheduler_concurrencyPolicies(t *testing.T) {
+	vectors := []struct {
+		policy ConcurrencyPolicy
+		active int
+		stats  JobStats
+	}{
+		{policy: ConcurrencyAllow, active: 3, stats: JobStats{Runs: 3}},
+		{policy: ConcurrencyForbid, active: 1, stats: JobStats{Runs: 1, Skipped: 2}},
+		{policy: ConcurrencyReplace, active: 1, stats: JobStats{Runs: 3, Replaced: 2}},
+	}
+
+	for _, v := range vectors {
+		clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+		s := NewScheduler(WithClock(clock))
+
+		release := make(chan struct{})
+		canceled := make(chan error, 3)
+		requireNoError(t, s.Add("job", MustParse("0 * * * * ?"), func(ctx context.Context) error {
+			select {
+			case <-release:
+				return nil
+			case <-ctx.Done():
+				canceled <- ctx.Err()
+				return ctx.Err()
+			}
+		}, WithConcurrencyPolicy(v.policy)))
+
+		s.Start()
+
+		for i := 0; i < 3; i++ {
+			clock.BlockUntil(1)
+			clock.Advance(time.Minute)
+		}
+		clock.BlockUntil(1)
+
+		stats, found := s.Stats("job")
+		if !found || stats != v.stats {
+			t.Fatalf("policy %d: %+v != %+v", v.policy, stats, v.stats)
+		}
+
+		// Replaced runs are canceled and the others are still in progress.
+		for i := 0; i < int(v.stats.Replaced); i++ {
+			select {
+			case err := <-canceled:
+				requireErrorIs(t, err, context.Canceled)
+			case <-time.After(time.Second):
+				t.Fatalf("policy %d: expected a canceled run", v.policy)
+			}
+		}
+
+		for i := 0; i < v.active; i++ {
+			release <- struct{}{}
+		}
+
+		requireNoError(t, s.Stop(context.Background()))
+	}
+}
+
+func TestScheduler_Stats_unknownJob(t *testing.T) {
+	s := NewScheduler()
+
+	if _, found := s.Stats("job"); found {
+		t.Fatal("expected no statistics")
+	}
+}
+
+func TestScheduler_concurrencyForbid_runsAfterCompletion(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+	s := NewScheduler(WithClock(clock))
+
+	requireNoError(t, s.Add("job", MustParse("0 * * * * ?"), func(context.Context) error {
+		return errors.New("run error")
+	}, WithConcurrencyPolicy(ConcurrencyForbid)))
+
+	s.Start()
+	defer func() { requireNoError(t, s.Stop(context.Background())) }()
+
+	for i := 0; i < 2; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+		clock.BlockUntil(1)
+		waitIdle(t, s, "job")
+	}
+
+	if stats, _ := s.Stats("job"); stats != (JobStats{Runs: 2}) {
+		t.Fatalf("unexpected statistics: %+v", stats)
+	}
+}
+
+func TestScheduler_concurrencyReplace_ignoresCanceledRuns(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+
+	errs := make(chan error, 2)
+	s := NewScheduler(WithClock(clock), WithErrorHandler(func(name string, err error) {
+		errs <- err
+	}))
+
+	started := make(chan struct{}, 2)
+	requireNoError(t, s.Add("job", MustParse("0 * * * * ?"), func(ctx context.Context) error {
+		started <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	}, WithConcurrencyPolicy(ConcurrencyReplace)))
+
+	s.Start()
+
+	for i := 0; i < 2; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+		<-started
+	}
+
+	// The first run is replaced and the second one is canceled by the stop.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	requireErrorIs(t, s.Stop(ctx), context.Canceled)
+	s.runs.Wait()
+
+	select {
+	case err := <-errs:
+		t.Fatalf("unexpected error: %v", err)
+	default:
+	}
+	if stats, _ := s.Stats("job"); stats != (JobStats{Runs: 2, Replaced: 1}) {
+		t.Fatalf("unexpected statistics: %+v", stats)
+	}
+}
+
+// waitIdle waits until no run of the job is in progress.
+func waitIdle(t testing.TB, s *Scheduler, name string) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		s.mu.Lock()
+		active := s.byName[name].active
+		s.mu.Unlock()
+		if active == 0 {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("job %s is still running", name)
+}
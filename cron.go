@@ -3,12 +3,26 @@
 package gocron
 
 import (
+	"slices"
 	"time"
 )
 
-var defaultParser = cronParser{}
+var defaultParser = Parser{}
 
 // TimeUnit represents a single part of a Cron expression.
+//
+// A time unit receives times truncated to the second and must follow this
+// contract so that it can be combined with other units:
+//   - a time is accepted when the unit returns it unchanged with `true`, and
+//     the same times must be accepted in both directions;
+//   - otherwise it returns the nearest accepted time with `true`, or a time
+//     further in the direction of the search with `false`, without skipping
+//     any accepted time;
+//   - a zero time with `false` means that no accepted time exists anymore;
+//   - the returned time is truncated to the second, and it can be in a
+//     different location than the given one.
+//
+// VerifyTimeUnit can be used to check the contract of an implementation.
 type TimeUnit interface {
 	// Next returns the next iteration of a schedule and `true` when valid,
 	// otherwise it returns a time after `next` and `false`.
@@ -24,6 +38,20 @@ type Schedule struct {
 	timeUnits []TimeUnit
 }
 
+// NewSchedule returns a schedule made of the time units which are evaluated in
+// the given order. An activation time is a time accepted by every unit. Units
+// of an existing schedule can be obtained with `Schedule.TimeUnits` to be
+// combined with custom implementations.
+func NewSchedule(units ...TimeUnit) Schedule {
+	return Schedule{timeUnits: slices.Clone(units)}
+}
+
+// TimeUnits returns the time units of the schedule in the order they are
+// evaluated.
+func (s Schedule) TimeUnits() []TimeUnit {
+	return slices.Clone(s.timeUnits)
+}
+
 // Parse returns a schedule from the Cron expression and returns an error if the
 // syntax is not supported or incorrect.
 func Parse(expression string) (Schedule, error) {
@@ -80,7 +108,7 @@ func (s Schedule) Previous(before time.Time) (prev time.Time) {
 	var ok bool
 
 	for !ok {
-		if prev.IsZero() || prev.Year() > rangeMaxYear {
+		if prev.IsZero() || prev.Year() > rangeMaxYear || prev.Year() < rangeMinYear {
 			return time.Time{}
 		}
 		prev, ok = s.prevBefore(prev)
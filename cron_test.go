@@ -87,6 +87,59 @@ func TestSchedule_Upcoming_returnsNextNthLastDayOfMonth(t *testing.T) {
 	testIterator(t, iter, expects)
 }
 
+func TestSchedule_Preceding_skipsMissingNthLastDaysOfMonth(t *testing.T) {
+	vectors := []struct {
+		expr    string
+		expects []string
+	}{
+		{
+			expr: "0 0 0 L-31 * ?",
+			expects: []string{
+				"2023-03-01 00:00:00 +0000 UTC",
+				"2023-01-01 00:00:00 +0000 UTC",
+				"2022-12-01 00:00:00 +0000 UTC",
+			},
+		},
+		{
+			expr: "0 0 0 L-30 * ?",
+			expects: []string{
+				"2023-04-01 00:00:00 +0000 UTC",
+				"2023-03-02 00:00:00 +0000 UTC",
+				"2023-01-02 00:00:00 +0000 UTC",
+			},
+		},
+		{
+			expr: "0 0 0 L-29 * ?",
+			expects: []string{
+				"2023-04-02 00:00:00 +0000 UTC",
+				"2023-03-03 00:00:00 +0000 UTC",
+				"2023-01-03 00:00:00 +0000 UTC",
+			},
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.expr, func(t *testing.T) {
+			iter := MustParse(v.expr).Preceding(time.Date(2023, time.April, 15, 0, 0, 0, 0, time.UTC))
+
+			testIterator(t, iter, v.expects)
+		})
+	}
+}
+
+func TestSchedule_Upcoming_skipsRangeFromMissingDay(t *testing.T) {
+	iter := MustParse("0 0 0 L-30-3 * ?").Upcoming(time.Date(2023, time.January, 31, 12, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-03-02 00:00:00 +0000 UTC",
+		"2023-03-03 00:00:00 +0000 UTC",
+		"2023-04-01 00:00:00 +0000 UTC",
+		"2023-04-02 00:00:00 +0000 UTC",
+		"2023-04-03 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
 func TestSchedule_Upcoming_returnsNextNthWeekdayOfMonth(t *testing.T) {
 	iter := MustParse("0 0 0 ? * 0#3").Upcoming(time.Date(2000, time.March, 15, 12, 5, 1, 0, time.UTC))
 	expects := []string{
@@ -106,6 +159,51 @@ func TestSchedule_Upcoming_returnsNextNthWeekdayOfMonth(t *testing.T) {
 	testIterator(t, iter, expects)
 }
 
+func TestSchedule_Upcoming_skipsMissingFifthWeekday(t *testing.T) {
+	iter := MustParse("0 0 0 ? * 1#5").Upcoming(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-01-30 00:00:00 +0000 UTC",
+		"2023-05-29 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Upcoming_returnsNthLastWeekdayOfMonth(t *testing.T) {
+	iter := MustParse("0 0 0 ? * 4#-2").Upcoming(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-01-19 00:00:00 +0000 UTC",
+		"2023-02-16 00:00:00 +0000 UTC",
+		"2023-03-23 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Upcoming_returnsRangeToLastDayOfMonth(t *testing.T) {
+	iter := MustParse("0 0 0 L-3-L * ?").Upcoming(time.Date(2023, time.February, 25, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-02-26 00:00:00 +0000 UTC",
+		"2023-02-27 00:00:00 +0000 UTC",
+		"2023-02-28 00:00:00 +0000 UTC",
+		"2023-03-29 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Upcoming_returnsIntervalToLastDayOfMonth(t *testing.T) {
+	iter := MustParse("0 0 0 10-L/10 * ?").Upcoming(time.Date(2023, time.January, 25, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-01-30 00:00:00 +0000 UTC",
+		"2023-02-10 00:00:00 +0000 UTC",
+		"2023-02-20 00:00:00 +0000 UTC",
+		"2023-03-10 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
 func TestSchedule_Upcoming_returnsNextYear(t *testing.T) {
 	iter := MustParse("0 0 0 1 6 ? 2010-2012").Upcoming(time.Date(2000, time.March, 15, 12, 5, 1, 0, time.UTC))
 	expects := []string{
@@ -126,6 +224,13 @@ func TestSchedule_Next_abortsExpressionWhichIsImpossible(t *testing.T) {
 	}
 }
 
+func TestSchedule_Previous_abortsExpressionWhichIsImpossible(t *testing.T) {
+	prev := MustParse("0 0 0 30 2 ?").Previous(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if !prev.IsZero() {
+		t.Fatalf("unexpected activation %v", prev)
+	}
+}
+
 func TestSchedule_Preceding_returnsPreviousSeconds(t *testing.T) {
 	iter := MustParse("15,5 * * * * *").Preceding(time.Date(2000, time.March, 15, 12, 5, 10, 0, time.UTC))
 	expects := []string{
@@ -190,6 +295,16 @@ func TestSchedule_Preceding_returnsPreviousWeekDays(t *testing.T) {
 	testIterator(t, iter, expects)
 }
 
+func TestSchedule_Preceding_returnsPreviousWeekDaysAtNoon(t *testing.T) {
+	iter := MustParse("0 0 12 ? * MON").Preceding(time.Date(2000, time.March, 14, 10, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2000-03-13 12:00:00 +0000 UTC",
+		"2000-03-06 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
 func TestSchedule_Preceding_returnsPreviousYear(t *testing.T) {
 	iter := MustParse("0 0 0 1 6 ? 1900/5").Preceding(time.Date(2003, time.March, 15, 12, 5, 1, 0, time.UTC))
 	expects := []string{
@@ -233,6 +348,29 @@ func TestSchedule_Preceding_returnsPreviousInInterval(t *testing.T) {
 	testIterator(t, iter, expects)
 }
 
+func TestSchedule_Preceding_returnsCurrentValueInInterval(t *testing.T) {
+	iter := MustParse("0 30/15 * * * *").Preceding(time.Date(2000, time.March, 15, 12, 45, 30, 0, time.UTC))
+	expects := []string{
+		"2000-03-15 12:45:00 +0000 UTC",
+		"2000-03-15 12:30:00 +0000 UTC",
+		"2000-03-15 11:45:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Preceding_returnsPreviousInBoundedInterval(t *testing.T) {
+	iter := MustParse("0-20/7 * * * * *").Preceding(time.Date(2000, time.March, 15, 12, 0, 45, 0, time.UTC))
+	expects := []string{
+		"2000-03-15 12:00:14 +0000 UTC",
+		"2000-03-15 12:00:07 +0000 UTC",
+		"2000-03-15 12:00:00 +0000 UTC",
+		"2000-03-15 11:59:14 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
 func TestSchedule_Preceding_returnsPreviousNthWeekday(t *testing.T) {
 	iter := MustParse("0 0 0 ? * 6#1").Preceding(time.Date(2000, time.March, 15, 12, 30, 0, 0, time.UTC))
 	expects := []string{
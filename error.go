@@ -9,7 +9,19 @@ var (
 	ErrMalformedExpression  = errors.New("expression is malformed")
 	ErrMalformedField       = errors.New("unexpected field value")
 	ErrMultipleNotSpecified = errors.New("only one `?` is supported")
+	ErrNotSpecifiedRequired = errors.New("one of the day fields must be `?`")
 	ErrValueOutsideRange    = errors.New("values are outside the supported range")
+	ErrHashKeyRequired      = errors.New("a hash key is required for `H`")
+
+	ErrMalformedCalendar     = errors.New("calendar is malformed")
+	ErrMalformedRecurrence   = errors.New("recurrence is malformed")
+	ErrUnsupportedRecurrence = errors.New("recurrence is not supported")
+	ErrNotExpressible        = errors.New("schedule cannot be expressed as a recurrence rule")
+	ErrNoActivation          = errors.New("schedule has no activation")
+	ErrInconsistentTimeUnit  = errors.New("time unit does not follow the contract")
+
+	ErrJobExists  = errors.New("a job with the same name already exists")
+	ErrRunTimeout = errors.New("run exceeded its deadline")
 )
 
 // TimeUnitError is an error returned when a time unit of a Cron expression is
@@ -50,9 +62,13 @@ const (
 	Months
 	WeekDays
 	Years
+	WeeksOfYear
+	DaysOfYear
 )
 
-var kinds = []string{"seconds", "minutes", "hours", "days", "months", "week days", "years"}
+var kinds = []string{
+	"seconds", "minutes", "hours", "days", "months", "week days", "years", "weeks of year", "days of year",
+}
 
 func (k TimeUnitKind) String() string {
 	return kinds[int(k)%len(kinds)]
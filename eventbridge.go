@@ -0,0 +1,286 @@
+package gocron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	eventBridgeExprMatches = 6
+
+	rangeMinEventBridgeYear = 1970
+	rangeMaxEventBridgeYear = 2199
+)
+
+var months = map[string]time.Month{
+	"jan": time.January,
+	"feb": time.February,
+	"mar": time.March,
+	"apr": time.April,
+	"may": time.May,
+	"jun": time.June,
+	"jul": time.July,
+	"aug": time.August,
+	"sep": time.September,
+	"oct": time.October,
+	"nov": time.November,
+	"dec": time.December,
+}
+
+// ParseEventBridge returns a schedule from an Amazon EventBridge (or
+// CloudWatch Events) schedule expression, either `cron(...)` or `rate(...)`,
+// and returns an error if the syntax is not supported or incorrect.
+//
+// Like for EventBridge rules, the activation times are computed in UTC. A rate
+// is anchored at the Unix epoch (e.g. `rate(5 minutes)` activates at every
+// fifth minute of the hour) as the creation time of the rule is unknown.
+func ParseEventBridge(expression string) (Schedule, error) {
+	return eventBridgeParser{}.Parse(expression)
+}
+
+// eventBridgeParser is a parser for Amazon EventBridge schedule expressions.
+type eventBridgeParser struct{}
+
+func (p eventBridgeParser) Parse(expression string) (Schedule, error) {
+	if !strings.HasSuffix(expression, ")") {
+		return Schedule{}, ErrMalformedExpression
+	}
+
+	if inner, found := strings.CutPrefix(expression, "cron("); found {
+		return p.parseCron(strings.TrimSuffix(inner, ")"))
+	}
+	if inner, found := strings.CutPrefix(expression, "rate("); found {
+		return p.parseRate(strings.TrimSuffix(inner, ")"))
+	}
+	return Schedule{}, ErrMalformedExpression
+}
+
+// parseCron returns a schedule from the six fields of a cron expression where
+// the seconds are omitted and the year is required.
+func (eventBridgeParser) parseCron(expression string) (schedule Schedule, err error) {
+	matches := strings.Split(expression, " ")
+	if len(matches) != eventBridgeExprMatches {
+		return schedule, ErrMalformedExpression
+	}
+
+	years, err := defaultParser.parse(matches[5], convertUnit, rangeMinEventBridgeYear, rangeMaxEventBridgeYear)
+	if err != nil {
+		return schedule, newTimeUnitErr(Years, err)
+	}
+	weekdays, err := defaultParser.parse(matches[4], convertEventBridgeWeekday, rangeMinWeekday, rangeMaxWeekday)
+	if err != nil {
+		return schedule, newTimeUnitErr(WeekDays, err)
+	}
+	months, err := defaultParser.parse(matches[3], convertMonth, rangeMinMonth, rangeMaxMonth)
+	if err != nil {
+		return schedule, newTimeUnitErr(Months, err)
+	}
+	days, err := defaultParser.parse(matches[2], convertEventBridgeDay, rangeMinDayOfMonth, rangeMaxDayOfMonth)
+	if err != nil {
+		return schedule, newTimeUnitErr(Days, err)
+	}
+	hours, err := defaultParser.parse(matches[1], convertUnit, rangeMinHour, rangeMaxHour)
+	if err != nil {
+		return schedule, newTimeUnitErr(Hours, err)
+	}
+	minutes, err := defaultParser.parse(matches[0], convertUnit, rangeMinMinute, rangeMaxMinute)
+	if err != nil {
+		return schedule, newTimeUnitErr(Minutes, err)
+	}
+
+	// Exactly one of the day fields must be not specified.
+	switch {
+	case isNotSpecified(weekdays) && isNotSpecified(days):
+		return schedule, ErrMultipleNotSpecified
+	case !isNotSpecified(weekdays) && !isNotSpecified(days):
+		return schedule, ErrNotSpecifiedRequired
+	}
+
+	schedule.timeUnits = []TimeUnit{
+		locationTimeUnit{loc: time.UTC},
+		yearTimeUnit(years),
+		monthTimeUnit(months),
+		dayTimeUnit(days),
+		weekdayTimeUnit(weekdays),
+		hourTimeUnit(hours),
+		minTimeUnit(minutes),
+		secTimeUnit{unitExpr(0)},
+	}
+
+	return schedule, nil
+}
+
+// parseRate returns a schedule from a rate expression (e.g. `5 minutes`). The
+// unit must be singular when the value is one, and plural otherwise.
+func (eventBridgeParser) parseRate(expression string) (schedule Schedule, err error) {
+	value, unit, found := strings.Cut(expression, " ")
+	if !found {
+		return schedule, ErrMalformedExpression
+	}
+
+	num, err := strconv.Atoi(value)
+	if err != nil || num < 1 {
+		return schedule, ErrMalformedExpression
+	}
+
+	if num > 1 {
+		unit, found = strings.CutSuffix(unit, "s")
+		if !found {
+			return schedule, ErrMalformedExpression
+		}
+	}
+
+	zero := []timeSet{unitExpr(0)}
+
+	var freq rruleFreq
+	var hours, minutes []timeSet
+
+	switch unit {
+	case "minute":
+		freq = minutely
+	case "hour":
+		freq = hourly
+		minutes = zero
+	case "day":
+		freq = daily
+		hours, minutes = zero, zero
+	default:
+		return schedule, ErrMalformedExpression
+	}
+
+	schedule.timeUnits = []TimeUnit{
+		locationTimeUnit{loc: time.UTC},
+		periodTimeUnit{freq: freq, interval: num, anchor: time.Unix(0, 0).UTC()},
+		hourTimeUnit(hours),
+		minTimeUnit(minutes),
+		secTimeUnit(zero),
+	}
+
+	return schedule, nil
+}
+
+// convertEventBridgeWeekday returns the week day where the values are in the
+// range 1 (Sunday) to 7 (Saturday).
+func convertEventBridgeWeekday(value string) (timeSet, error) {
+	if value == "L" {
+		return unitExpr(time.Saturday), nil
+	}
+	if prefix, found := strings.CutSuffix(value, "L"); found {
+		weekday, err := eventBridgeWeekday(prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		return lastWeekDayOfMonthExpr{weekday: weekday}, nil
+	}
+	if parts := strings.Split(value, "#"); len(parts) == nthSplitSize {
+		weekday, err := eventBridgeWeekday(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		nth, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		if nth < 1 {
+			// Only the occurrences from the beginning of the month are
+			// supported by EventBridge.
+			return nil, ErrValueOutsideRange
+		}
+
+		return nthWeekdayOfMonthExpr{weekday: weekday, nth: nth}, nil
+	}
+
+	weekday, err := eventBridgeWeekday(value)
+	return unitExpr(weekday), err
+}
+
+func eventBridgeWeekday(value string) (time.Weekday, error) {
+	if weekday, found := weekdays[strings.ToLower(value)]; found {
+		return weekday, nil
+	}
+
+	num, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+	if num < 1 || num > 7 {
+		return 0, ErrValueOutsideRange
+	}
+	return time.Weekday(num - 1), nil
+}
+
+// convertEventBridgeDay returns the day of the month which supports the nearest
+// week day (e.g. `15W` or `LW`) in addition to the last day of the month. An
+// offset from the last day (e.g. `L-2`) is rejected like EventBridge does.
+func convertEventBridgeDay(value string) (timeSet, error) {
+	switch {
+	case value == "L":
+		return nthLastDayOfMonthExpr{}, nil
+	case value == "LW":
+		return nearestWeekdayExpr{last: true}, nil
+	case strings.HasPrefix(value, "L"):
+		return nil, ErrMalformedField
+	}
+	if prefix, found := strings.CutSuffix(value, "W"); found {
+		day, err := strconv.Atoi(prefix)
+		return nearestWeekdayExpr{day: day}, err
+	}
+	return convertUnit(value)
+}
+
+// convertMonth returns the month of a number or an abbreviated English name.
+func convertMonth(value string) (timeSet, error) {
+	if month, found := months[strings.ToLower(value)]; found {
+		return unitExpr(month), nil
+	}
+	return convertUnit(value)
+}
+
+// nearestWeekdayExpr is a specialized expression field to determine the week
+// day (Monday to Friday) nearest to a day of the month, without moving to a
+// different month.
+type nearestWeekdayExpr struct {
+	day int
+	// last is true when the day is the last of the month.
+	last bool
+}
+
+func (e nearestWeekdayExpr) NearestCandidate(t time.Time, _ int, forwards bool) (int, result) {
+	value := e.valueFor(t)
+	_, direction := unitExpr(value).NearestCandidate(t, t.Day(), forwards)
+	return value, direction
+}
+
+func (e nearestWeekdayExpr) valueFor(t time.Time) int {
+	lastDayOfMonth := findLastDayOfMonth(t).Day()
+
+	day := e.day
+	if e.last {
+		day = lastDayOfMonth
+	}
+	if day > lastDayOfMonth {
+		// The day does not exist in this month.
+		return day
+	}
+
+	switch time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location()).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDayOfMonth {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
+
+func (e nearestWeekdayExpr) SubsetOf(min, max int) bool {
+	return e.last || (e.day >= min && e.day <= max)
+}
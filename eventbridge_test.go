@@ -0,0 +1,167 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseEventBridge(t *testing.T) {
+	after := time.Date(2023, time.June, 4, 12, 0, 0, 0, time.UTC)
+	vectors := []struct {
+		expr    string
+		expects []string
+	}{
+		{
+			"cron(0 10 * * ? *)",
+			[]string{"2023-06-05 10:00:00 +0000 UTC", "2023-06-06 10:00:00 +0000 UTC"},
+		},
+		{
+			"cron(0 18 ? * MON-FRI *)",
+			[]string{"2023-06-05 18:00:00 +0000 UTC", "2023-06-06 18:00:00 +0000 UTC"},
+		},
+		{
+			"cron(0/5 8-17 ? * 2-6 *)",
+			[]string{"2023-06-05 08:00:00 +0000 UTC", "2023-06-05 08:05:00 +0000 UTC"},
+		},
+		{
+			"cron(0 9 ? * 2#1 *)",
+			[]string{"2023-06-05 09:00:00 +0000 UTC", "2023-07-03 09:00:00 +0000 UTC"},
+		},
+		{
+			"cron(0 0 ? * 6L *)",
+			[]string{"2023-06-30 00:00:00 +0000 UTC", "2023-07-28 00:00:00 +0000 UTC"},
+		},
+		{
+			"cron(0 0 ? * L *)",
+			[]string{"2023-06-10 00:00:00 +0000 UTC", "2023-06-17 00:00:00 +0000 UTC"},
+		},
+		{
+			"cron(0 0 L * ? *)",
+			[]string{"2023-06-30 00:00:00 +0000 UTC", "2023-07-31 00:00:00 +0000 UTC"},
+		},
+		{
+			"cron(0 0 1W * ? *)",
+			[]string{"2023-07-03 00:00:00 +0000 UTC", "2023-08-01 00:00:00 +0000 UTC", "2023-09-01 00:00:00 +0000 UTC"},
+		},
+		{
+			"cron(0 0 17W * ? *)",
+			[]string{"2023-06-16 00:00:00 +0000 UTC", "2023-07-17 00:00:00 +0000 UTC", "2023-08-17 00:00:00 +0000 UTC"},
+		},
+		{
+			"cron(0 0 LW * ? *)",
+			[]string{"2023-06-30 00:00:00 +0000 UTC", "2023-07-31 00:00:00 +0000 UTC", "2023-08-31 00:00:00 +0000 UTC"},
+		},
+		{
+			"cron(0 0 LW 9 ? *)",
+			[]string{"2023-09-29 00:00:00 +0000 UTC", "2024-09-30 00:00:00 +0000 UTC"},
+		},
+		{
+			"cron(30 12 1 JAN,JUL ? 2024-2025)",
+			[]string{"2024-01-01 12:30:00 +0000 UTC", "2024-07-01 12:30:00 +0000 UTC", "2025-01-01 12:30:00 +0000 UTC"},
+		},
+		{
+			"rate(1 minute)",
+			[]string{"2023-06-04 12:01:00 +0000 UTC", "2023-06-04 12:02:00 +0000 UTC"},
+		},
+		{
+			"rate(7 minutes)",
+			[]string{"2023-06-04 12:07:00 +0000 UTC", "2023-06-04 12:14:00 +0000 UTC"},
+		},
+		{
+			"rate(5 hours)",
+			[]string{"2023-06-04 17:00:00 +0000 UTC", "2023-06-04 22:00:00 +0000 UTC"},
+		},
+		{
+			"rate(2 days)",
+			[]string{"2023-06-06 00:00:00 +0000 UTC", "2023-06-08 00:00:00 +0000 UTC"},
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.expr, func(t *testing.T) {
+			schedule, err := ParseEventBridge(v.expr)
+			requireNoError(t, err)
+
+			testIterator(t, schedule.Upcoming(after), v.expects)
+		})
+	}
+}
+
+func TestParseEventBridge_evaluatesInUTC(t *testing.T) {
+	schedule, err := ParseEventBridge("cron(0 10 * * ? *)")
+	requireNoError(t, err)
+
+	next := schedule.Next(time.Date(2023, time.June, 4, 12, 0, 0, 0, time.FixedZone("CEST", 2*3600)))
+	requireTimeEqual(t, next, "2023-06-05 10:00:00 +0000 UTC")
+}
+
+func TestParseEventBridge_iteratesBackwards(t *testing.T) {
+	schedule, err := ParseEventBridge("cron(0 0 1W * ? *)")
+	requireNoError(t, err)
+
+	expects := []string{
+		"2023-06-01 00:00:00 +0000 UTC",
+		"2023-05-01 00:00:00 +0000 UTC",
+		"2023-04-03 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, schedule.Preceding(time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)), expects)
+}
+
+func TestParseEventBridge_abortsOnMalformedExpression(t *testing.T) {
+	vectors := []string{
+		"0 0 * * ? *",
+		"cron(0 0 * * ?)",
+		"cron(0 0 * * ? * *)",
+		"at(2023-06-04T00:00:00)",
+		"rate(5)",
+		"rate(0 minutes)",
+		"rate(1 minutes)",
+		"rate(5 minute)",
+		"rate(5 weeks)",
+	}
+
+	for _, v := range vectors {
+		t.Run(v, func(t *testing.T) {
+			_, err := ParseEventBridge(v)
+			requireErrorIs(t, err, ErrMalformedExpression)
+		})
+	}
+}
+
+func TestParseEventBridge_abortsOnOffsetFromLastDay(t *testing.T) {
+	for _, v := range []string{"cron(0 0 L-2 * ? *)", "cron(0 0 L-3-L * ? *)"} {
+		_, err := ParseEventBridge(v)
+		requireErrorIs(t, err, ErrMalformedField)
+	}
+}
+
+func TestParseEventBridge_requiresOneNotSpecified(t *testing.T) {
+	_, err := ParseEventBridge("cron(0 0 * * * *)")
+	requireErrorIs(t, err, ErrNotSpecifiedRequired)
+
+	_, err = ParseEventBridge("cron(0 0 ? * ? *)")
+	requireErrorIs(t, err, ErrMultipleNotSpecified)
+}
+
+func TestParseEventBridge_abortsOnValuesOutsideRange(t *testing.T) {
+	vectors := []string{
+		"cron(60 0 * * ? *)",
+		"cron(0 24 * * ? *)",
+		"cron(0 0 32 * ? *)",
+		"cron(0 0 32W * ? *)",
+		"cron(0 0 * 13 ? *)",
+		"cron(0 0 ? * 0 *)",
+		"cron(0 0 ? * 8 *)",
+		"cron(0 0 ? * 8L *)",
+		"cron(0 0 ? * 2#6 *)",
+		"cron(0 0 * * ? 1969)",
+	}
+
+	for _, v := range vectors {
+		t.Run(v, func(t *testing.T) {
+			_, err := ParseEventBridge(v)
+			requireErrorIs(t, err, ErrValueOutsideRange)
+		})
+	}
+}
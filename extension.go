@@ -0,0 +1,101 @@
+package gocron
+
+import (
+	"regexp"
+	"time"
+)
+
+// CandidateResult is the result of the search of the nearest candidate of a
+// field set.
+type CandidateResult int
+
+const (
+	// CandidateMiss means that the set has no candidate in the direction of the
+	// search within the current period (e.g. the month for days).
+	CandidateMiss CandidateResult = iota
+	// CandidateHit means that the given value belongs to the set.
+	CandidateHit
+	// CandidateInRange means that the returned value is the nearest candidate
+	// in the direction of the search.
+	CandidateInRange
+)
+
+// FieldSet is a set of values of a field of an expression which can be added
+// to the parser with WithFieldToken.
+type FieldSet interface {
+	// NearestCandidate returns the nearest value of the set from the given
+	// value, forwards or backwards, and the result of the search. The time
+	// gives the context of the value (e.g. the month and the year of a day).
+	NearestCandidate(t time.Time, value int, forwards bool) (int, CandidateResult)
+
+	// SubsetOf returns true if the set is included in the range [min, max] of
+	// the field.
+	SubsetOf(min, max int) bool
+}
+
+// FieldSetFactory returns the field set of a token from the submatches of its
+// pattern, or an error if the token is malformed.
+type FieldSetFactory func(submatches []string) (FieldSet, error)
+
+// WithFieldToken returns an option to add a token to a field of the expression
+// (e.g. `EASTER` in the days field). The pattern must match the whole token,
+// which cannot contain the characters `,`, `/` or `-` used by lists, intervals
+// and ranges. Tokens can then be used like any other value of the field.
+func WithFieldToken(kind TimeUnitKind, pattern *regexp.Regexp, factory FieldSetFactory) ParserOption {
+	return func(p *Parser) {
+		p.tokens = append(p.tokens, fieldToken{kind: kind, pattern: pattern, factory: factory})
+	}
+}
+
+// fieldToken is a token of a field added to the parser.
+type fieldToken struct {
+	kind    TimeUnitKind
+	pattern *regexp.Regexp
+	factory FieldSetFactory
+}
+
+// withTokens returns a converter which uses the tokens of the field before the
+// default conversion.
+func (p Parser) withTokens(kind TimeUnitKind, convFn converterFn) converterFn {
+	return func(value string) (timeSet, error) {
+		for _, token := range p.tokens {
+			if token.kind != kind {
+				continue
+			}
+
+			submatches := token.pattern.FindStringSubmatch(value)
+			if submatches == nil || submatches[0] != value {
+				continue
+			}
+
+			set, err := token.factory(submatches)
+			if err != nil {
+				return nil, err
+			}
+			return fieldSetExpr{set: set}, nil
+		}
+		return convFn(value)
+	}
+}
+
+// fieldSetExpr is an expression field that adapts a field set.
+type fieldSetExpr struct {
+	set FieldSet
+}
+
+func (e fieldSetExpr) NearestCandidate(t time.Time, other int, forwards bool) (int, result) {
+	value, res := e.set.NearestCandidate(t, other, forwards)
+
+	switch res {
+	case CandidateHit:
+		return value, hit
+	case CandidateInRange:
+		return value, inRange
+	default:
+		return value, miss
+	}
+}
+
+func (e fieldSetExpr) SubsetOf(min, max int) bool {
+	return e.set.SubsetOf(min, max)
+}
@@ -0,0 +1,146 @@
+package gocron
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// easterSet is a field set of the days field which matches the Easter Sunday
+// of the year with an offset in days.
+type easterSet struct {
+	offset int
+}
+
+func (s easterSet) NearestCandidate(t time.Time, value int, forwards bool) (int, CandidateResult) {
+	date := easterSunday(t.Year(), t.Location()).AddDate(0, 0, s.offset)
+	if date.Month() != t.Month() {
+		return value, CandidateMiss
+	}
+
+	switch day := date.Day(); {
+	case day == value:
+		return day, CandidateHit
+	case (forwards && day > value) || (!forwards && day < value):
+		return day, CandidateInRange
+	default:
+		return day, CandidateMiss
+	}
+}
+
+func (easterSet) SubsetOf(min, max int) bool {
+	return true
+}
+
+// easterSunday returns the date of Easter Sunday using the anonymous Gregorian
+// algorithm.
+func easterSunday(year int, loc *time.Location) time.Time {
+	a, b, c := year%19, year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+// quarterEndSet is a field set of the months field which matches the last
+// month of each quarter.
+type quarterEndSet struct{}
+
+func (quarterEndSet) NearestCandidate(_ time.Time, value int, forwards bool) (int, CandidateResult) {
+	if value%3 == 0 {
+		return value, CandidateHit
+	}
+	if forwards {
+		return value + 3 - value%3, CandidateInRange
+	}
+	if value < 3 {
+		return value, CandidateMiss
+	}
+	return value - value%3, CandidateInRange
+}
+
+func (quarterEndSet) SubsetOf(min, max int) bool {
+	return min <= 3 && max >= 12
+}
+
+func newExtendedParser() Parser {
+	return NewParser(
+		WithFieldToken(Days, regexp.MustCompile(`EASTER(\+(\d+))?`), func(submatches []string) (FieldSet, error) {
+			if submatches[2] == "" {
+				return easterSet{}, nil
+			}
+			offset, err := strconv.Atoi(submatches[2])
+			return easterSet{offset: offset}, err
+		}),
+		WithFieldToken(Months, regexp.MustCompile(`Q`), func([]string) (FieldSet, error) {
+			return quarterEndSet{}, nil
+		}),
+	)
+}
+
+func TestParser_Parse_supportsFieldTokens(t *testing.T) {
+	schedule, err := newExtendedParser().Parse("0 0 10 EASTER,EASTER+1 * ?")
+	requireNoError(t, err)
+
+	iter := schedule.Upcoming(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2024-03-31 10:00:00 +0000 UTC",
+		"2024-04-01 10:00:00 +0000 UTC",
+		"2025-04-20 10:00:00 +0000 UTC",
+		"2025-04-21 10:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+
+	iter = schedule.Preceding(time.Date(2025, time.April, 21, 0, 0, 0, 0, time.UTC))
+	expects = []string{
+		"2025-04-20 10:00:00 +0000 UTC",
+		"2024-04-01 10:00:00 +0000 UTC",
+		"2024-03-31 10:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestParser_Parse_supportsFieldTokensInMonths(t *testing.T) {
+	schedule, err := newExtendedParser().Parse("0 0 0 L Q ?")
+	requireNoError(t, err)
+
+	iter := schedule.Upcoming(time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2024-03-31 00:00:00 +0000 UTC",
+		"2024-06-30 00:00:00 +0000 UTC",
+		"2024-09-30 00:00:00 +0000 UTC",
+		"2024-12-31 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestParser_Parse_abortsOnFieldTokenError(t *testing.T) {
+	errToken := errors.New("token error")
+
+	parser := NewParser(WithFieldToken(Hours, regexp.MustCompile(`NOON`), func([]string) (FieldSet, error) {
+		return nil, errToken
+	}))
+
+	_, err := parser.Parse("0 0 NOON * * ?")
+	requireErrorIs(t, err, newTimeUnitErr(Hours, errToken))
+
+	// The token is only added to its field.
+	_, err = parser.Parse("0 NOON 0 * * ?")
+	requireErrorIs(t, err, newTimeUnitErr(Minutes, strconv.ErrSyntax))
+
+	// The whole value must match the pattern.
+	_, err = parser.Parse("0 0 NOON1 * * ?")
+	requireErrorIs(t, err, newTimeUnitErr(Hours, strconv.ErrSyntax))
+}
@@ -0,0 +1,109 @@
+package gocron
+
+import (
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// hashMaxDayOfMonth is the highest day of the month that a hashed value can
+// take so that it exists in every month.
+const hashMaxDayOfMonth = 28
+
+// resolveHash returns the field where each `H` item is replaced by the value
+// derived from the hash key of the parser and the kind of the field.
+//
+// The supported forms are `H` for a value within the whole range of the field,
+// `H(a-b)` for a value within a range, and `H/n` or `H(a-b)/n` for an interval
+// starting at a hashed offset.
+func (p Parser) resolveHash(kind TimeUnitKind, expr string, convFn converterFn, min, max int) (string, error) {
+	items := strings.Split(expr, ",")
+	for i, item := range items {
+		rest, found := strings.CutPrefix(item, "H")
+		if !found || (rest != "" && !strings.HasPrefix(rest, "(") && !strings.HasPrefix(rest, "/")) {
+			// Other values starting with the character are left to the
+			// conversion of the field (e.g. tokens added to the parser).
+			continue
+		}
+		if p.hashKey == "" {
+			return expr, ErrHashKeyRequired
+		}
+
+		from, to := min, max
+
+		if kind == Years && !strings.HasPrefix(rest, "(") {
+			// A year within the whole range is most likely far in the past or
+			// in the future.
+			return expr, ErrMalformedField
+		}
+
+		if strings.HasPrefix(rest, "(") {
+			end := strings.Index(rest, ")")
+			if end < 0 {
+				return expr, ErrMalformedField
+			}
+
+			r, err := parseRange(rest[1:end], convFn)
+			if err != nil {
+				return expr, err
+			}
+
+			lower, okFrom := r.from.(unitExpr)
+			upper, okTo := r.to.(unitExpr)
+			if !okFrom || !okTo || lower > upper {
+				return expr, ErrMalformedField
+			}
+			if int(lower) < min || int(upper) > max {
+				return expr, ErrValueOutsideRange
+			}
+
+			from, to = int(lower), int(upper)
+			rest = rest[end+1:]
+		}
+
+		hash := p.hash(kind)
+
+		switch {
+		case rest == "":
+			if kind == Days && to > hashMaxDayOfMonth {
+				if from > hashMaxDayOfMonth {
+					// No value of the range exists in every month.
+					return expr, ErrValueOutsideRange
+				}
+				to = hashMaxDayOfMonth
+			}
+			items[i] = strconv.Itoa(from + int(hash%uint64(to-from+1)))
+		case strings.HasPrefix(rest, "/"):
+			incr, err := strconv.Atoi(rest[1:])
+			if err != nil {
+				return expr, err
+			}
+			if incr < 1 {
+				return expr, ErrMalformedField
+			}
+
+			// The offset stays within the range when the step is wider (e.g.
+			// `H(0-5)/10`).
+			width := to - from + 1
+			if incr < width {
+				width = incr
+			}
+
+			start := from + int(hash%uint64(width))
+			items[i] = strconv.Itoa(start) + "-" + strconv.Itoa(to) + rest
+		default:
+			return expr, ErrMalformedField
+		}
+	}
+
+	return strings.Join(items, ","), nil
+}
+
+// hash returns a value derived from the hash key and the kind of a field so
+// that two fields of the same expression get different values.
+func (p Parser) hash(kind TimeUnitKind) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(p.hashKey))
+	_, _ = h.Write([]byte{0, byte(kind)})
+	return h.Sum64()
+}
@@ -0,0 +1,147 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParser_Parse_resolvesHashDeterministically(t *testing.T) {
+	a, err := NewParser(WithHashKey("backup")).Parse("0 H H * * ?")
+	requireNoError(t, err)
+	b, err := NewParser(WithHashKey("backup")).Parse("0 H H * * ?")
+	requireNoError(t, err)
+
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+	if !a.Next(from).Equal(b.Next(from)) {
+		t.Fatalf("%v != %v", a.Next(from), b.Next(from))
+	}
+}
+
+func TestParser_Parse_resolvesHashPerKey(t *testing.T) {
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+	seen := map[time.Time]bool{}
+
+	for _, key := range []string{"backup", "report", "cleanup", "index", "sync"} {
+		schedule, err := NewParser(WithHashKey(key)).Parse("0 H H * * ?")
+		requireNoError(t, err)
+		seen[schedule.Next(from)] = true
+	}
+
+	if len(seen) < 2 {
+		t.Fatal("expected different activation times")
+	}
+}
+
+func TestParser_Parse_resolvesHashWithinRange(t *testing.T) {
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+
+	for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		schedule, err := NewParser(WithHashKey(key)).Parse("0 H(10-20) H(0-7) H * ?")
+		requireNoError(t, err)
+
+		next := schedule.Next(from)
+		if next.Minute() < 10 || next.Minute() > 20 || next.Hour() > 7 || next.Day() > hashMaxDayOfMonth {
+			t.Fatalf("%v is outside the range", next)
+		}
+	}
+}
+
+func TestParser_Parse_resolvesHashInterval(t *testing.T) {
+	parser := NewParser(WithHashKey("backup"))
+
+	schedule, err := parser.Parse("0 H/15 * * * ?")
+	requireNoError(t, err)
+
+	offset := int(parser.hash(Minutes) % 15)
+
+	iter := schedule.Upcoming(time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC))
+	for i := 0; i < 8; i++ {
+		next := iter.Next()
+		if next.Minute()%15 != offset {
+			t.Fatalf("%v is not at offset %d", next, offset)
+		}
+	}
+}
+
+func TestParser_Parse_resolvesHashWeekdayNames(t *testing.T) {
+	schedule, err := NewParser(WithHashKey("backup")).Parse("0 0 0 ? * H(MON-FRI)")
+	requireNoError(t, err)
+
+	next := schedule.Next(time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC))
+	if next.Weekday() == time.Saturday || next.Weekday() == time.Sunday {
+		t.Fatalf("%v is not a week day", next)
+	}
+}
+
+func TestParser_Parse_abortsOnHashWithoutKey(t *testing.T) {
+	_, err := Parse("0 H * * * ?")
+	requireErrorIs(t, err, ErrHashKeyRequired)
+}
+
+func TestParser_Parse_abortsOnHashAfterLastDayOfEveryMonth(t *testing.T) {
+	parser := NewParser(WithHashKey("backup"))
+
+	for _, expr := range []string{"0 0 0 H(29-31) * ?", "0 0 0 H(30-31) * ?"} {
+		_, err := parser.Parse(expr)
+		requireErrorIs(t, err, ErrValueOutsideRange)
+	}
+
+	schedule, err := parser.Parse("0 0 0 H(27-31) * ?")
+	requireNoError(t, err)
+
+	day := schedule.Next(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)).Day()
+	if day < 27 || day > hashMaxDayOfMonth {
+		t.Fatalf("day outside of the range: %d", day)
+	}
+}
+
+func TestParser_Parse_resolvesHashIntervalWiderThanRange(t *testing.T) {
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+
+	for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		schedule, err := NewParser(WithHashKey(key)).Parse("0 H(0-5)/10 * * * ?")
+		requireNoError(t, err)
+
+		next := schedule.Next(from)
+		if next.IsZero() || next.Minute() > 5 {
+			t.Fatalf("%s: %v is outside the range", key, next)
+		}
+	}
+}
+
+func TestParser_Parse_resolvesHashYearWithinRange(t *testing.T) {
+	parser := NewParser(WithHashKey("backup"))
+
+	for _, expr := range []string{"0 0 0 1 1 ? H", "0 0 0 1 1 ? H/2"} {
+		_, err := parser.Parse(expr)
+		requireErrorIs(t, err, ErrMalformedField)
+	}
+
+	schedule, err := parser.Parse("0 0 0 1 1 ? H(2030-2039)")
+	requireNoError(t, err)
+
+	if year := schedule.Next(time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)).Year(); year < 2030 || year > 2039 {
+		t.Fatalf("year outside of the range: %d", year)
+	}
+}
+
+func TestParser_Parse_abortsOnMalformedHash(t *testing.T) {
+	vectors := []string{
+		"0 H( * * * ?",
+		"0 H(7-1) * * * ?",
+		"0 H/0 * * * ?",
+		"0 H5 * * * ?",
+		"0 H(0-60) * * * ?",
+	}
+
+	parser := NewParser(WithHashKey("backup"))
+
+	for _, v := range vectors {
+		t.Run(v, func(t *testing.T) {
+			_, err := parser.Parse(v)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
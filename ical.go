@@ -0,0 +1,681 @@
+package gocron
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	icalDateLayout     = "20060102"
+	icalDateTimeLayout = "20060102T150405"
+	icalUTCLayout      = "20060102T150405Z"
+
+	// icalMaxLineLength is the number of octets after which a content line is
+	// folded.
+	icalMaxLineLength = 75
+)
+
+// HolidayCalendar is a set of periods during which a schedule must not be
+// activated. It is usually loaded from an iCalendar (.ics) file.
+type HolidayCalendar struct {
+	holidays []holiday
+}
+
+// LoadICalendar reads the iCalendar file at the given path and returns the
+// holiday calendar built from its events.
+func LoadICalendar(path string) (*HolidayCalendar, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseICalendar(f)
+}
+
+// ParseICalendar returns a holiday calendar built from the VEVENT components of
+// an iCalendar stream. All-day and timed events are supported, as well as
+// yearly recurrences. It returns an error if the stream is malformed or uses a
+// recurrence that is not supported.
+func ParseICalendar(r io.Reader) (*HolidayCalendar, error) {
+	lines, err := unfoldICalLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cal := &HolidayCalendar{}
+
+	var event *icalEvent
+	for i, line := range lines {
+		name, params, value, err := parseICalContentLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d", err, i+1)
+		}
+
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			event = &icalEvent{}
+		case name == "END" && value == "VEVENT":
+			if event == nil {
+				return nil, fmt.Errorf("%w: line %d: unexpected end of event", ErrMalformedCalendar, i+1)
+			}
+
+			h, err := event.holiday()
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d", err, i+1)
+			}
+
+			cal.holidays = append(cal.holidays, h)
+			event = nil
+		case event != nil:
+			err = event.set(name, params, value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d", err, i+1)
+			}
+		}
+	}
+
+	if event != nil {
+		return nil, fmt.Errorf("%w: unterminated event", ErrMalformedCalendar)
+	}
+
+	return cal, nil
+}
+
+// WriteICalendar writes an iCalendar stream with one event per named schedule.
+// Each event recurs according to the schedule starting at the first activation
+// after `from`, either with a recurrence rule or with the list of activation
+// times up to `until` when the schedule cannot be expressed as a rule (see
+// Schedule.ToRRule). The time `from` is also used as the stamp of the events.
+func WriteICalendar(w io.Writer, schedules map[string]Schedule, from, until time.Time) error {
+	names := make([]string, 0, len(schedules))
+	for name := range schedules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var events []string
+	var zones []string
+
+	for _, name := range names {
+		recurrence, err := schedules[name].ToRRule(from, until)
+		if err != nil {
+			return fmt.Errorf("schedule %q: %w", name, err)
+		}
+
+		events = append(events,
+			"BEGIN:VEVENT",
+			"UID:"+escapeICalText(name),
+			formatICalTime("DTSTAMP", from.UTC()),
+			"SUMMARY:"+escapeICalText(name))
+
+		for _, line := range strings.Split(recurrence, "\n") {
+			events = append(events, line)
+
+			params, _, _ := strings.Cut(line, ":")
+			if _, zone, found := strings.Cut(params, ";TZID="); found && !slices.Contains(zones, zone) {
+				zones = append(zones, zone)
+			}
+		}
+
+		events = append(events, "END:VEVENT")
+	}
+
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//Gilthoniel//gocron//EN"}
+
+	// Each time zone referenced by the events is described by a component.
+	sort.Strings(zones)
+	for _, zone := range zones {
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			return fmt.Errorf("time zone %q: %w", zone, err)
+		}
+
+		lines = append(lines, formatICalTimezone(loc, from.Year())...)
+	}
+
+	lines = append(lines, events...)
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		_, err := io.WriteString(w, foldICalLine(line))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// escapeICalText returns the value escaped for a text property.
+func escapeICalText(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(value)
+}
+
+// foldICalLine returns the content line terminated by a CRLF and folded so that
+// no physical line is longer than the limit, without splitting a character.
+func foldICalLine(line string) string {
+	var b strings.Builder
+
+	size := 0
+	for _, r := range line {
+		n := len(string(r))
+		if size+n > icalMaxLineLength {
+			b.WriteString("\r\n ")
+			size = 1
+		}
+
+		b.WriteRune(r)
+		size += n
+	}
+
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+// Contains returns true when the given time falls inside one of the holidays
+// of the calendar.
+func (c *HolidayCalendar) Contains(t time.Time) bool {
+	_, _, found := c.find(t)
+	return found
+}
+
+// find returns the boundaries of a holiday that contains the given time if
+// any.
+func (c *HolidayCalendar) find(t time.Time) (start, end time.Time, found bool) {
+	for _, h := range c.holidays {
+		start, end, found = h.find(t)
+		if found {
+			return
+		}
+	}
+	return
+}
+
+// Excluding returns a copy of the schedule which skips any activation time
+// that falls inside a holiday of the calendar.
+func (s Schedule) Excluding(cal *HolidayCalendar) Schedule {
+	return Schedule{timeUnits: append(slices.Clone(s.timeUnits), exclusionTimeUnit{cal: cal})}
+}
+
+// exclusionTimeUnit is a time unit implementation that rejects the times
+// contained in a holiday calendar.
+type exclusionTimeUnit struct {
+	cal *HolidayCalendar
+}
+
+// Next implements TimeUnit.
+func (u exclusionTimeUnit) Next(next time.Time) (time.Time, bool) {
+	_, end, found := u.cal.find(next)
+	if !found {
+		return next, true
+	}
+
+	// Move to the end of the holiday which is not included.
+	return end, false
+}
+
+// Previous implements TimeUnit.
+func (u exclusionTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	start, _, found := u.cal.find(before)
+	if !found {
+		return before, true
+	}
+
+	return start.Add(-time.Second), false
+}
+
+// holiday is a period of time, possibly recurring every few years, included
+// in a holiday calendar. The period starts inclusively and ends exclusively.
+type holiday struct {
+	start time.Time
+	end   time.Time
+	// floating is true when the period is expressed as a wall clock which
+	// means it applies to the location of the time evaluated.
+	floating bool
+	// interval is the number of years between two occurrences, or zero when
+	// the holiday does not recur.
+	interval int
+	count    int
+	until    time.Time
+}
+
+func (h holiday) find(t time.Time) (start, end time.Time, found bool) {
+	start, end, until := h.start, h.end, h.until
+	if h.floating {
+		start, end = inLocation(start, t.Location()), inLocation(end, t.Location())
+		until = inLocation(until, t.Location())
+	}
+
+	if h.interval == 0 {
+		found = !t.Before(start) && t.Before(end)
+		return
+	}
+
+	// Only the occurrences around the year of the time can contain it.
+	year := t.In(start.Location()).Year()
+	for y := year - 1; y <= year; y++ {
+		n := y - start.Year()
+		if n < 0 || n%h.interval != 0 || !h.exists(start, n) {
+			continue
+		}
+		if h.count > 0 && h.countBefore(start, n) >= h.count {
+			continue
+		}
+
+		occStart, occEnd := start.AddDate(n, 0, 0), end.AddDate(n, 0, 0)
+		if !h.until.IsZero() && occStart.After(until) {
+			continue
+		}
+		if !t.Before(occStart) && t.Before(occEnd) {
+			return occStart, occEnd, true
+		}
+	}
+
+	return start, end, false
+}
+
+// exists returns true when the occurrence n years after the start is a valid
+// date (e.g. February 29th only exists on leap years).
+func (h holiday) exists(start time.Time, n int) bool {
+	return start.AddDate(n, 0, 0).Month() == start.Month()
+}
+
+// countBefore returns the number of occurrences that happen before the one n
+// years after the start. Invalid dates are not counted.
+func (h holiday) countBefore(start time.Time, n int) (count int) {
+	for i := 0; i < n; i += h.interval {
+		if h.exists(start, i) {
+			count++
+		}
+	}
+	return
+}
+
+// inLocation returns the same wall clock as the given time in a different
+// location.
+func inLocation(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
+
+// icalEvent gathers the properties of a VEVENT component.
+type icalEvent struct {
+	start    icalTime
+	end      icalTime
+	duration string
+	rule     string
+}
+
+func (e *icalEvent) set(name string, params map[string]string, value string) (err error) {
+	switch name {
+	case "DTSTART":
+		e.start, err = parseICalTime(params, value)
+	case "DTEND":
+		e.end, err = parseICalTime(params, value)
+	case "DURATION":
+		e.duration = value
+	case "RRULE":
+		e.rule = value
+	}
+	return
+}
+
+func (e *icalEvent) holiday() (h holiday, err error) {
+	if e.start.IsZero() {
+		return h, fmt.Errorf("%w: event without start", ErrMalformedCalendar)
+	}
+
+	h.start = e.start.Time
+	h.floating = e.start.floating
+
+	switch {
+	case !e.end.IsZero():
+		h.end = e.end.Time
+	case e.duration != "":
+		h.end, err = addICalDuration(h.start, e.duration)
+		if err != nil {
+			return
+		}
+	case e.start.allDay:
+		h.end = h.start.AddDate(0, 0, 1)
+	default:
+		h.end = h.start
+	}
+
+	if e.rule != "" {
+		err = h.setRule(e.rule)
+	}
+	return
+}
+
+func (h *holiday) setRule(rule string) error {
+	parts, err := splitRRuleParts(rule)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMalformedCalendar, err)
+	}
+
+	h.interval = 1
+
+	for key, value := range parts {
+		switch key {
+		case "FREQ":
+			if value != "YEARLY" {
+				return fmt.Errorf("%w: frequency %s", ErrUnsupportedRecurrence, value)
+			}
+		case "INTERVAL":
+			h.interval, err = strconv.Atoi(value)
+			if err == nil && h.interval < 1 {
+				err = ErrMalformedCalendar
+			}
+		case "COUNT":
+			h.count, err = strconv.Atoi(value)
+		case "UNTIL":
+			var until icalTime
+			until, err = parseICalTime(nil, value)
+			h.until = until.Time
+		default:
+			return fmt.Errorf("%w: rule part %s", ErrUnsupportedRecurrence, key)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, found := parts["FREQ"]; !found {
+		return fmt.Errorf("%w: rule without frequency", ErrMalformedCalendar)
+	}
+
+	return nil
+}
+
+// splitRRuleParts returns the key-value pairs of a recurrence rule (e.g.
+// `FREQ=YEARLY;COUNT=2`).
+func splitRRuleParts(rule string) (map[string]string, error) {
+	parts := make(map[string]string)
+	for _, part := range strings.Split(rule, ";") {
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("%w: rule part %q", ErrMalformedRecurrence, part)
+		}
+
+		parts[strings.ToUpper(key)] = value
+	}
+	return parts, nil
+}
+
+// icalTime is a time parsed from an iCalendar property.
+type icalTime struct {
+	time.Time
+	allDay   bool
+	floating bool
+}
+
+func parseICalTime(params map[string]string, value string) (t icalTime, err error) {
+	switch {
+	case params["VALUE"] == "DATE" || len(value) == len(icalDateLayout):
+		t.allDay = true
+		t.floating = true
+		t.Time, err = time.Parse(icalDateLayout, value)
+	case strings.HasSuffix(value, "Z"):
+		t.Time, err = time.Parse(icalUTCLayout, value)
+	case params["TZID"] != "":
+		var loc *time.Location
+		loc, err = time.LoadLocation(params["TZID"])
+		if err != nil {
+			return
+		}
+
+		t.Time, err = time.ParseInLocation(icalDateTimeLayout, value, loc)
+	default:
+		t.floating = true
+		t.Time, err = time.Parse(icalDateTimeLayout, value)
+	}
+
+	if err != nil {
+		err = fmt.Errorf("%w: %v", ErrMalformedCalendar, err)
+	}
+	return
+}
+
+// addICalDuration returns the time plus the iCalendar duration (e.g. `P1D`,
+// `PT1H30M` or `P2W`). Days and weeks are nominal so that they span a whole
+// day even across a daylight saving time transition.
+func addICalDuration(t time.Time, value string) (time.Time, error) {
+	rest, found := strings.CutPrefix(strings.TrimPrefix(value, "+"), "P")
+	if !found || rest == "" {
+		return t, fmt.Errorf("%w: duration %q", ErrMalformedCalendar, value)
+	}
+
+	malformed := fmt.Errorf("%w: duration %q", ErrMalformedCalendar, value)
+
+	var days int
+	var clock time.Duration
+	var inTime bool
+	var digits, timeParts int
+	num := 0
+	for _, r := range rest {
+		switch {
+		case r >= '0' && r <= '9':
+			num = num*10 + int(r-'0')
+			digits++
+			continue
+		case r == 'T' && !inTime && digits == 0:
+			inTime = true
+			continue
+		case digits == 0:
+			// A designator must follow a number.
+			return t, malformed
+		case r == 'W' && !inTime:
+			days += num * daysInWeek
+		case r == 'D' && !inTime:
+			days += num
+		case r == 'H' && inTime:
+			clock += time.Duration(num) * time.Hour
+		case r == 'M' && inTime:
+			clock += time.Duration(num) * time.Minute
+		case r == 'S' && inTime:
+			clock += time.Duration(num) * time.Second
+		default:
+			return t, malformed
+		}
+		if inTime {
+			timeParts++
+		}
+		num, digits = 0, 0
+	}
+
+	// A number without a designator (e.g. `P1`), or a time without any
+	// component (e.g. `P1DT`), is malformed.
+	if digits > 0 || (inTime && timeParts == 0) {
+		return t, malformed
+	}
+
+	return t.AddDate(0, 0, days).Add(clock), nil
+}
+
+// unfoldICalLines returns the logical lines of an iCalendar stream where the
+// long lines folded on multiple physical lines are joined back.
+func unfoldICalLines(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, scanner.Err()
+}
+
+// parseICalContentLine splits a content line (e.g. `DTSTART;TZID=UTC:...`) in
+// its name, parameters and value.
+func parseICalContentLine(line string) (name string, params map[string]string, value string, err error) {
+	// Look for the first colon outside of a quoted parameter value.
+	quoted := false
+	sep := -1
+	for i, r := range line {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ':' && !quoted {
+			sep = i
+			break
+		}
+	}
+	if sep < 0 {
+		err = ErrMalformedCalendar
+		return
+	}
+
+	value = line[sep+1:]
+	parts := strings.Split(line[:sep], ";")
+	name = strings.ToUpper(parts[0])
+
+	params = make(map[string]string)
+	for _, param := range parts[1:] {
+		key, val, found := strings.Cut(param, "=")
+		if !found {
+			err = ErrMalformedCalendar
+			return
+		}
+
+		params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+
+	return
+}
+
+// formatICalTimezone returns the lines of a VTIMEZONE component describing the
+// location with the observances of the given year, which are repeated every
+// year.
+func formatICalTimezone(loc *time.Location, year int) []string {
+	lines := []string{"BEGIN:VTIMEZONE", "TZID:" + loc.String()}
+
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+
+	_, prev := start.In(loc).Zone()
+	found := false
+
+	for t := start.Add(time.Hour); !t.After(end); t = t.Add(time.Hour) {
+		if _, offset := t.In(loc).Zone(); offset != prev {
+			lines = append(lines, formatICalObservance(loc, zoneTransition(loc, t.Add(-time.Hour), t))...)
+			prev = offset
+			found = true
+		}
+	}
+
+	if !found {
+		name, offset := start.In(loc).Zone()
+		lines = append(lines,
+			"BEGIN:STANDARD",
+			"DTSTART:"+time.Date(year, time.January, 1, 0, 0, 0, 0, loc).Format(icalDateTimeLayout),
+			"TZOFFSETFROM:"+formatICalOffset(offset),
+			"TZOFFSETTO:"+formatICalOffset(offset),
+			"TZNAME:"+name,
+			"END:STANDARD")
+	}
+
+	return append(lines, "END:VTIMEZONE")
+}
+
+// zoneTransition returns the first second of the location at the offset of the
+// time `after`, given that the time `before` is at a different offset.
+func zoneTransition(loc *time.Location, before, after time.Time) time.Time {
+	_, offset := before.In(loc).Zone()
+	for after.Sub(before) > time.Second {
+		mid := before.Add(after.Sub(before) / 2).Truncate(time.Second)
+		if _, o := mid.In(loc).Zone(); o == offset {
+			before = mid
+		} else {
+			after = mid
+		}
+	}
+	return after
+}
+
+// formatICalObservance returns the lines of a STANDARD or DAYLIGHT component
+// starting at a transition of the location, and repeated every year on the same
+// week day of the month (e.g. the last Sunday of March) when the transition of
+// the following year follows that rule.
+func formatICalObservance(loc *time.Location, transition time.Time) []string {
+	_, from := transition.Add(-time.Second).In(loc).Zone()
+	name, to := transition.In(loc).Zone()
+
+	kind := "STANDARD"
+	if transition.In(loc).IsDST() {
+		kind = "DAYLIGHT"
+	}
+
+	// The onset is expressed in the wall clock before the transition.
+	onset := transition.In(time.FixedZone("", from))
+
+	nth := (onset.Day()-1)/daysInWeek + 1
+	lastDay := time.Date(onset.Year(), onset.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if onset.Day()+daysInWeek > lastDay {
+		nth = -1
+	}
+
+	lines := []string{"BEGIN:" + kind, "DTSTART:" + onset.Format(icalDateTimeLayout)}
+
+	// A transition on a fixed date, or the last one of a zone, only happens at
+	// the onset.
+	if repeatsYearly(loc, onset, nth, from, to) {
+		lines = append(lines, fmt.Sprintf("RRULE:FREQ=YEARLY;BYMONTH=%d;BYDAY=%d%s",
+			onset.Month(), nth, rruleWeekdays[onset.Weekday()]))
+	}
+
+	return append(lines,
+		"TZOFFSETFROM:"+formatICalOffset(from),
+		"TZOFFSETTO:"+formatICalOffset(to),
+		"TZNAME:"+name,
+		"END:"+kind)
+}
+
+// repeatsYearly returns true if the location moves between the offsets at the
+// wall clock of the onset on the nth week day of the same month of the
+// following year.
+func repeatsYearly(loc *time.Location, onset time.Time, nth, from, to int) bool {
+	month := time.Date(onset.Year()+1, onset.Month(), 1, onset.Hour(), onset.Minute(), onset.Second(), 0,
+		onset.Location())
+
+	day := nthWeekdayOfMonthExpr{weekday: onset.Weekday(), nth: nth}.valueFor(month)
+	if day == 0 {
+		return false
+	}
+
+	next := month.AddDate(0, 0, day-1)
+	_, before := next.Add(-time.Second).In(loc).Zone()
+	_, after := next.In(loc).Zone()
+	return before == from && after == to
+}
+
+// formatICalOffset returns an UTC offset in seconds as `+hhmm`, or `+hhmmss`
+// when it has seconds.
+func formatICalOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+
+	value := fmt.Sprintf("%c%02d%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		value += fmt.Sprintf("%02d", offset%60)
+	}
+	return value
+}
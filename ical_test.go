@@ -0,0 +1,319 @@
+package gocron
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHolidayCalendar_Contains_allDayEvents(t *testing.T) {
+	cal, err := LoadICalendar("testdata/holidays.ics")
+	requireNoError(t, err)
+
+	vectors := []struct {
+		date   time.Time
+		expect bool
+	}{
+		{time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2020, time.January, 1, 23, 59, 59, 0, time.UTC), true},
+		{time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2019, time.January, 1, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2035, time.January, 1, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2035, time.January, 1, 12, 0, 0, 0, time.FixedZone("", 5*3600)), true},
+		{time.Date(2022, time.December, 25, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2022, time.December, 26, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, time.December, 25, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, time.February, 29, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, time.February, 28, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2028, time.February, 29, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2032, time.February, 29, 12, 0, 0, 0, time.UTC), false},
+		{time.Date(2023, time.August, 18, 12, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, time.August, 19, 0, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.date.String(), func(t *testing.T) {
+			if cal.Contains(v.date) != v.expect {
+				t.Fatalf("expected %v", v.expect)
+			}
+		})
+	}
+}
+
+func TestHolidayCalendar_Contains_timedEvents(t *testing.T) {
+	cal, err := LoadICalendar("testdata/maintenance.ics")
+	requireNoError(t, err)
+
+	vectors := []struct {
+		date   time.Time
+		expect bool
+	}{
+		{time.Date(2023, time.June, 1, 21, 59, 59, 0, time.UTC), false},
+		{time.Date(2023, time.June, 1, 22, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, time.June, 2, 1, 59, 59, 0, time.UTC), true},
+		{time.Date(2023, time.June, 2, 2, 0, 0, 0, time.UTC), false},
+		{time.Date(2023, time.June, 15, 9, 59, 59, 0, time.UTC), false},
+		{time.Date(2023, time.June, 15, 10, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, time.June, 15, 11, 29, 59, 0, time.UTC), true},
+		{time.Date(2023, time.June, 15, 11, 30, 0, 0, time.UTC), false},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.date.String(), func(t *testing.T) {
+			if cal.Contains(v.date) != v.expect {
+				t.Fatalf("expected %v", v.expect)
+			}
+		})
+	}
+}
+
+func TestSchedule_Excluding_skipsHolidays(t *testing.T) {
+	cal, err := LoadICalendar("testdata/holidays.ics")
+	requireNoError(t, err)
+
+	iter := MustParse("0 0 12 * * ?").Excluding(cal).Upcoming(time.Date(2022, time.December, 22, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2022-12-22 12:00:00 +0000 UTC",
+		"2022-12-23 12:00:00 +0000 UTC",
+		"2022-12-26 12:00:00 +0000 UTC",
+		"2022-12-27 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Excluding_skipsHolidaysBackwards(t *testing.T) {
+	cal, err := LoadICalendar("testdata/holidays.ics")
+	requireNoError(t, err)
+
+	iter := MustParse("0 0 12 * * ?").Excluding(cal).Preceding(time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-01-02 12:00:00 +0000 UTC",
+		"2022-12-31 12:00:00 +0000 UTC",
+		"2022-12-30 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Excluding_skipsTimedEvents(t *testing.T) {
+	cal, err := LoadICalendar("testdata/maintenance.ics")
+	requireNoError(t, err)
+
+	iter := MustParse("0 0 * * * ?").Excluding(cal).Upcoming(time.Date(2023, time.June, 1, 20, 30, 0, 0, time.UTC))
+	expects := []string{
+		"2023-06-01 21:00:00 +0000 UTC",
+		"2023-06-02 02:00:00 +0000 UTC",
+		"2023-06-02 03:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestParseICalendar_abortsOnUnsupportedRecurrence(t *testing.T) {
+	_, err := ParseICalendar(strings.NewReader(strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20200101",
+		"RRULE:FREQ=MONTHLY",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")))
+
+	requireErrorIs(t, err, ErrUnsupportedRecurrence)
+}
+
+func TestParseICalendar_abortsOnMalformedContent(t *testing.T) {
+	vectors := []string{
+		"BEGIN:VEVENT\r\nDTSTART:2020\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nDTSTART:20200101T000000Z\r\nDURATION:1D\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nDTSTART:20200101T000000Z\r\nDURATION:P1\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nDTSTART:20200101T000000Z\r\nDURATION:P1DT2\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nDTSTART:20200101T000000Z\r\nDURATION:PT\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nDTSTART:20200101T000000Z\r\nDURATION:P1DT\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nDTSTART:20200101T000000Z\r\nDURATION:PD\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nDTSTART:20200101T000000Z\r\nRRULE:INTERVAL=2\r\nEND:VEVENT",
+		"BEGIN:VEVENT\r\nDTSTART:20200101T000000Z",
+		"END:VEVENT",
+		"BEGIN",
+	}
+
+	for _, v := range vectors {
+		t.Run(v, func(t *testing.T) {
+			_, err := ParseICalendar(strings.NewReader(v))
+			requireErrorIs(t, err, ErrMalformedCalendar)
+		})
+	}
+}
+
+// --- Utilities
+
+func requireNoError(t testing.TB, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestWriteICalendar(t *testing.T) {
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2023, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	var b strings.Builder
+	err := WriteICalendar(&b, map[string]Schedule{
+		"backup":            MustParse("0 0 2 * * ?"),
+		"report, quarterly": MustParse("0 0 9 1 1,4,7,10 ? 2023"),
+	}, from, until)
+	requireNoError(t, err)
+
+	expect := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Gilthoniel//gocron//EN",
+		"BEGIN:VEVENT",
+		"UID:backup",
+		"DTSTAMP:20230604T000000Z",
+		"SUMMARY:backup",
+		"DTSTART:20230604T020000Z",
+		"RRULE:FREQ=DAILY;BYHOUR=2;BYMINUTE=0;BYSECOND=0;UNTIL=20231231T000000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:report\\, quarterly",
+		"DTSTAMP:20230604T000000Z",
+		"SUMMARY:report\\, quarterly",
+		"DTSTART:20230701T090000Z",
+		"RDATE:20230701T090000Z,20231001T090000Z",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	if b.String() != expect {
+		t.Fatalf("%q != %q", b.String(), expect)
+	}
+}
+
+func TestWriteICalendar_describesTimeZones(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Zurich")
+	requireNoError(t, err)
+
+	var b strings.Builder
+	err = WriteICalendar(&b, map[string]Schedule{
+		"backup": MustParse("0 0 2 * * ?"),
+	}, time.Date(2023, time.June, 4, 0, 0, 0, 0, loc), time.Time{})
+	requireNoError(t, err)
+
+	expect := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Gilthoniel//gocron//EN",
+		"BEGIN:VTIMEZONE",
+		"TZID:Europe/Zurich",
+		"BEGIN:DAYLIGHT",
+		"DTSTART:20230326T020000",
+		"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU",
+		"TZOFFSETFROM:+0100",
+		"TZOFFSETTO:+0200",
+		"TZNAME:CEST",
+		"END:DAYLIGHT",
+		"BEGIN:STANDARD",
+		"DTSTART:20231029T030000",
+		"RRULE:FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU",
+		"TZOFFSETFROM:+0200",
+		"TZOFFSETTO:+0100",
+		"TZNAME:CET",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:backup",
+		"DTSTAMP:20230603T220000Z",
+		"SUMMARY:backup",
+		"DTSTART;TZID=Europe/Zurich:20230604T020000",
+		"RRULE:FREQ=DAILY;BYHOUR=2;BYMINUTE=0;BYSECOND=0",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	if b.String() != expect {
+		t.Fatalf("%q != %q", b.String(), expect)
+	}
+}
+
+func TestFormatICalTimezone_writesLastTransitionOnce(t *testing.T) {
+	// Daylight saving time was abolished in Brazil after its end in February
+	// 2019.
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	requireNoError(t, err)
+
+	expect := []string{
+		"BEGIN:VTIMEZONE",
+		"TZID:America/Sao_Paulo",
+		"BEGIN:STANDARD",
+		"DTSTART:20190217T000000",
+		"TZOFFSETFROM:-0200",
+		"TZOFFSETTO:-0300",
+		"TZNAME:-03",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+	}
+
+	if lines := formatICalTimezone(loc, 2019); !slices.Equal(lines, expect) {
+		t.Fatalf("%q != %q", lines, expect)
+	}
+}
+
+func TestWriteICalendar_writesUnnamedZonesInUTC(t *testing.T) {
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.FixedZone("", 3600))
+	until := time.Date(2023, time.June, 7, 0, 0, 0, 0, time.UTC)
+
+	var b strings.Builder
+	err := WriteICalendar(&b, map[string]Schedule{
+		"backup": MustParse("0 0 2 * * ?"),
+	}, from, until)
+	requireNoError(t, err)
+
+	if strings.Contains(b.String(), "TZID") {
+		t.Fatalf("unexpected time zone in %q", b.String())
+	}
+
+	lines, err := unfoldICalLines(strings.NewReader(b.String()))
+	requireNoError(t, err)
+
+	requireContains(t, lines, "RDATE:20230604T010000Z,20230605T010000Z,20230606T010000Z")
+}
+
+func TestWriteICalendar_foldsLongLines(t *testing.T) {
+	var b strings.Builder
+	err := WriteICalendar(&b, map[string]Schedule{
+		"every hour": MustParse("0 0 * * * ? 2023"),
+	}, time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC), time.Date(2023, time.June, 5, 0, 0, 0, 0, time.UTC))
+	requireNoError(t, err)
+
+	for _, line := range strings.Split(b.String(), "\r\n") {
+		if len(line) > icalMaxLineLength {
+			t.Fatalf("line is too long: %q", line)
+		}
+	}
+
+	lines, err := unfoldICalLines(strings.NewReader(b.String()))
+	requireNoError(t, err)
+
+	requireContains(t, lines, "RDATE:20230604T010000Z,20230604T020000Z,20230604T030000Z,20230604T040000Z,"+
+		"20230604T050000Z,20230604T060000Z,20230604T070000Z,20230604T080000Z,20230604T090000Z,"+
+		"20230604T100000Z,20230604T110000Z,20230604T120000Z,20230604T130000Z,20230604T140000Z,"+
+		"20230604T150000Z,20230604T160000Z,20230604T170000Z,20230604T180000Z,20230604T190000Z,"+
+		"20230604T200000Z,20230604T210000Z,20230604T220000Z,20230604T230000Z,20230605T000000Z")
+}
+
+func requireContains(t testing.TB, values []string, expected string) {
+	t.Helper()
+	for _, v := range values {
+		if v == expected {
+			return
+		}
+	}
+	t.Fatalf("%q not found in %q", expected, values)
+}
@@ -0,0 +1,103 @@
+package gocron
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"time"
+)
+
+// WithJitter returns a schedule where each activation time is delayed by a
+// pseudo-random offset between zero and `max` (excluded), truncated to the
+// second. The offset is derived from the seed and the nominal activation time
+// so that the same activation is always delayed by the same amount, both
+// forwards and backwards.
+//
+// Activations of the original schedule closer than `max` to each other might
+// be reordered, in which case the jittered times are returned in order.
+func (s Schedule) WithJitter(max time.Duration, seed uint64) Schedule {
+	return Schedule{timeUnits: []TimeUnit{jitterTimeUnit{schedule: s, max: max.Truncate(time.Second), seed: seed}}}
+}
+
+// Nominal returns the activation time of the original schedule which produced
+// the given activation time when the schedule has a jitter, otherwise the time
+// is returned unchanged. A zero time is returned when the time is not a
+// jittered activation time.
+func (s Schedule) Nominal(activation time.Time) time.Time {
+	for _, unit := range s.timeUnits {
+		if u, ok := unit.(jitterTimeUnit); ok {
+			return u.nominal(activation)
+		}
+	}
+	return activation
+}
+
+// jitterTimeUnit is a time unit implementation that delays the activation times
+// of a schedule.
+type jitterTimeUnit struct {
+	schedule Schedule
+	max      time.Duration
+	seed     uint64
+}
+
+// Next implements TimeUnit.
+func (u jitterTimeUnit) Next(next time.Time) (time.Time, bool) {
+	var best time.Time
+
+	// Any nominal time in the jitter window before `next` can produce a time
+	// after it, and no nominal time after the best candidate can do better.
+	nominal := u.schedule.Next(next.Add(-u.max))
+	for !nominal.IsZero() && (best.IsZero() || nominal.Before(best)) {
+		if jittered := nominal.Add(u.offset(nominal)); !jittered.Before(next) && (best.IsZero() || jittered.Before(best)) {
+			best = jittered
+		}
+		nominal = u.schedule.Next(nominal)
+	}
+
+	return best, !best.IsZero()
+}
+
+// Previous implements TimeUnit.
+func (u jitterTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	var best time.Time
+
+	// A nominal time is never after its jittered time, and nominal times more
+	// than the jitter before the best candidate cannot do better.
+	nominal := u.schedule.Previous(before.Add(time.Second))
+	for !nominal.IsZero() && (best.IsZero() || nominal.Add(u.max).After(best)) {
+		if jittered := nominal.Add(u.offset(nominal)); !jittered.After(before) && (best.IsZero() || jittered.After(best)) {
+			best = jittered
+		}
+		nominal = u.schedule.Previous(nominal)
+	}
+
+	return best, !best.IsZero()
+}
+
+// nominal returns the nominal time which is delayed to the activation time, or
+// a zero time if none exists.
+func (u jitterTimeUnit) nominal(activation time.Time) time.Time {
+	nominal := u.schedule.Previous(activation.Add(time.Second))
+	for !nominal.IsZero() && nominal.Add(u.max).After(activation) {
+		if nominal.Add(u.offset(nominal)).Equal(activation) {
+			return nominal
+		}
+		nominal = u.schedule.Previous(nominal)
+	}
+	return time.Time{}
+}
+
+// offset returns the delay of a nominal activation time.
+func (u jitterTimeUnit) offset(nominal time.Time) time.Duration {
+	seconds := uint64(u.max / time.Second)
+	if seconds == 0 {
+		return 0
+	}
+
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], u.seed)
+	binary.BigEndian.PutUint64(buf[8:], uint64(nominal.Unix()))
+
+	h := fnv.New64a()
+	_, _ = h.Write(buf[:])
+	return time.Duration(h.Sum64()%seconds) * time.Second
+}
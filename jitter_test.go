@@ -0,0 +1,87 @@
+package gocron
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSchedule_WithJitter_delaysActivations(t *testing.T) {
+	schedule := MustParse("0 0 * * * ?").WithJitter(30*time.Second, 42)
+
+	iter := schedule.Upcoming(time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC))
+	for i := 0; i < 48; i++ {
+		next := iter.Next()
+		if next.Minute() != 0 || next.Second() >= 30 {
+			t.Fatalf("%v is outside the jitter", next)
+		}
+		if nominal := schedule.Nominal(next); !nominal.Equal(next.Truncate(time.Hour)) {
+			t.Fatalf("%v is not the nominal time of %v", nominal, next)
+		}
+	}
+}
+
+func TestSchedule_WithJitter_isReproducible(t *testing.T) {
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+
+	a := MustParse("0 0 * * * ?").WithJitter(time.Minute, 1).Upcoming(from)
+	b := MustParse("0 0 * * * ?").WithJitter(time.Minute, 1).Upcoming(from)
+	c := MustParse("0 0 * * * ?").WithJitter(time.Minute, 2).Upcoming(from)
+
+	var differs bool
+	for i := 0; i < 24; i++ {
+		next := a.Next()
+		if !next.Equal(b.Next()) {
+			t.Fatal("expected the same activation times")
+		}
+		differs = differs || !next.Equal(c.Next())
+	}
+	if !differs {
+		t.Fatal("expected different activation times with another seed")
+	}
+}
+
+func TestSchedule_WithJitter_isConsistentBackwards(t *testing.T) {
+	// The jitter is larger than the interval so that activations are
+	// reordered.
+	schedule := MustParse("*/10 * * * * ?").WithJitter(25*time.Second, 7)
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+
+	var forwards []time.Time
+	for iter := schedule.Upcoming(from); len(forwards) < 50; {
+		next := iter.Next()
+		if len(forwards) > 0 && !next.After(forwards[len(forwards)-1]) {
+			t.Fatalf("%v is not after %v", next, forwards[len(forwards)-1])
+		}
+		forwards = append(forwards, next)
+	}
+
+	var backwards []time.Time
+	for iter := schedule.Preceding(forwards[len(forwards)-1]); len(backwards) < len(forwards)-1; {
+		backwards = append(backwards, iter.Next())
+	}
+	slices.Reverse(backwards)
+
+	if !slices.EqualFunc(forwards[:len(forwards)-1], backwards, time.Time.Equal) {
+		t.Fatalf("%v != %v", forwards, backwards)
+	}
+}
+
+func TestSchedule_WithJitter_keepsScheduleWithoutJitter(t *testing.T) {
+	iter := MustParse("0 0 12 * * ?").WithJitter(0, 42).Upcoming(time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-06-04 12:00:00 +0000 UTC",
+		"2023-06-05 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Nominal_returnsZeroWhenNotActivation(t *testing.T) {
+	schedule := MustParse("0 0 * * * ?").WithJitter(30*time.Second, 42)
+
+	next := schedule.Next(time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC))
+	if !schedule.Nominal(next.Add(time.Second)).IsZero() {
+		t.Fatal("expected a zero time")
+	}
+}
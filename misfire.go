@@ -0,0 +1,61 @@
+package gocron
+
+import "time"
+
+const (
+	// defaultMisfireThreshold is the delay after which an activation which has
+	// not run is considered missed.
+	defaultMisfireThreshold = time.Minute
+
+	// defaultMaxMisfires is the maximum number of missed activations which are
+	// caught up by the MisfireFireAll policy.
+	defaultMaxMisfires = 100
+)
+
+// MisfirePolicy defines how a job handles the activations missed while the
+// process was down or paused.
+type MisfirePolicy int
+
+const (
+	// MisfireFireOnce runs the job once for all the missed activations.
+	MisfireFireOnce MisfirePolicy = iota
+	// MisfireFireAll runs the job for each missed activation, up to a maximum
+	// number of runs.
+	MisfireFireAll
+	// MisfireSkip ignores the missed activations and waits for the next one.
+	MisfireSkip
+)
+
+// WithMisfirePolicy returns an option to set the misfire policy of a job, which
+// is MisfireFireOnce by default.
+func WithMisfirePolicy(policy MisfirePolicy) JobOption {
+	return func(e *entry) {
+		e.misfire = policy
+	}
+}
+
+// WithMaxMisfires returns an option to set the maximum number of missed
+// activations caught up by the MisfireFireAll policy, which is 100 by default.
+func WithMaxMisfires(n int) JobOption {
+	return func(e *entry) {
+		e.maxMisfires = n
+	}
+}
+
+// WithMisfireThreshold returns an option to set the delay after which an
+// activation which has not run is considered missed, which is one minute by
+// default. An activation late by no more than the threshold runs normally.
+func WithMisfireThreshold(d time.Duration) JobOption {
+	return func(e *entry) {
+		e.misfireThreshold = d
+	}
+}
+
+// WithLastRun returns an option to set the time of the last recorded run of a
+// job, for instance before a restart of the process. The activations between
+// the last run and now are handled by the misfire policy.
+func WithLastRun(t time.Time) JobOption {
+	return func(e *entry) {
+		e.lastRun = t
+	}
+}
@@ -0,0 +1,122 @@
+package gocron
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScheduler_misfirePolicies(t *testing.T) {
+	now := time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC)
+
+	vectors := []struct {
+		name   string
+		expr   string
+		opts   []JobOption
+		expect int64
+	}{
+		{
+			name:   "no last run",
+			expr:   "0 0 12 * * ?",
+			expect: 0,
+		},
+		{
+			name:   "fire once by default",
+			expr:   "0 0 12 * * ?",
+			opts:   []JobOption{WithLastRun(now.AddDate(0, 0, -3))},
+			expect: 1,
+		},
+		{
+			name:   "fire all",
+			expr:   "0 0 12 * * ?",
+			opts:   []JobOption{WithLastRun(now.AddDate(0, 0, -3)), WithMisfirePolicy(MisfireFireAll)},
+			expect: 3,
+		},
+		{
+			name:   "fire all with a maximum",
+			expr:   "0 0 12 * * ?",
+			opts:   []JobOption{WithLastRun(now.AddDate(0, 0, -3)), WithMisfirePolicy(MisfireFireAll), WithMaxMisfires(2)},
+			expect: 2,
+		},
+		{
+			name:   "fire all with the default maximum",
+			expr:   "0 * * * * ?",
+			opts:   []JobOption{WithLastRun(now.AddDate(0, -1, 0)), WithMisfirePolicy(MisfireFireAll)},
+			expect: defaultMaxMisfires + 1,
+		},
+		{
+			name:   "skip",
+			expr:   "0 0 12 * * ?",
+			opts:   []JobOption{WithLastRun(now.AddDate(0, 0, -3)), WithMisfirePolicy(MisfireSkip)},
+			expect: 0,
+		},
+		{
+			name:   "skip all but the activation within the threshold",
+			expr:   "0 */15 * * * ?",
+			opts:   []JobOption{WithLastRun(now.AddDate(0, 0, -1)), WithMisfirePolicy(MisfireSkip)},
+			expect: 1,
+		},
+		{
+			name: "skip with a shorter threshold",
+			expr: "0 29 * * * ?",
+			opts: []JobOption{
+				WithLastRun(now.Add(-time.Hour)),
+				WithMisfirePolicy(MisfireSkip),
+				WithMisfireThreshold(30 * time.Second),
+			},
+			expect: 0,
+		},
+		{
+			name:   "last run is the latest activation",
+			expr:   "0 0 * * * ?",
+			opts:   []JobOption{WithLastRun(now.Add(-30 * time.Minute))},
+			expect: 0,
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.name, func(t *testing.T) {
+			clock := NewFakeClock(now)
+			s := NewScheduler(WithClock(clock))
+
+			var runs atomic.Int64
+			requireNoError(t, s.Add("job", MustParse(v.expr), func(context.Context) error {
+				runs.Add(1)
+				return nil
+			}, v.opts...))
+
+			s.Start()
+			clock.BlockUntil(1)
+			requireNoError(t, s.Stop(context.Background()))
+
+			if runs.Load() != v.expect {
+				t.Fatalf("%d runs != %d", runs.Load(), v.expect)
+			}
+		})
+	}
+}
+
+func TestScheduler_misfiresWhenLate(t *testing.T) {
+	clock := &jumpClock{FakeClock: NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))}
+	s := NewScheduler(WithClock(clock))
+
+	runs := make(chan time.Time, 10)
+	requireNoError(t, s.Add("job", MustParse("0 0 * * * ?"), func(context.Context) error {
+		runs <- clock.Now()
+		return nil
+	}, WithMisfirePolicy(MisfireSkip)))
+
+	s.Start()
+	defer func() { requireNoError(t, s.Stop(context.Background())) }()
+
+	// The process is paused long enough for the activation to be missed.
+	clock.BlockUntil(1)
+	clock.jump(2 * time.Minute)
+	clock.Advance(30 * time.Minute)
+	clock.BlockUntil(1)
+	requireNoRun(t, runs)
+
+	clock.Advance(58 * time.Minute)
+	requireRun(t, runs, time.Date(2023, time.June, 4, 12, 0, 0, 0, time.UTC))
+}
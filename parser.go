@@ -13,6 +13,10 @@ const (
 	intervalSplitSize = 2
 	nthSplitSize      = 2
 
+	// maxWeekdaysInMonth is the maximum number of occurrences of a week day in
+	// a month.
+	maxWeekdaysInMonth = 5
+
 	rangeMinYear       = 1
 	rangeMaxYear       = 9999
 	rangeMinWeekday    = 0
@@ -27,38 +31,99 @@ const (
 	rangeMaxMinute     = 59
 	rangeMinSecond     = 0
 	rangeMaxSecond     = 59
+	rangeMinWeekOfYear = 1
+	rangeMaxWeekOfYear = 53
+	rangeMinDayOfYear  = 1
+	rangeMaxDayOfYear  = 366
 )
 
-// cronParser is a parser from Cron expressions.
-type cronParser struct{}
+// Parser is a parser of Cron expressions which can be configured with options.
+// The zero value is ready to use.
+type Parser struct {
+	hashKey     string
+	businessCal BusinessCalendar
+	weekOfYear  bool
+	dayOfYear   bool
+	tokens      []fieldToken
+}
+
+// ParserOption is an option to configure a parser.
+type ParserOption func(*Parser)
+
+// WithHashKey returns an option to set the key used to derive the values of the
+// `H` character, usually the name of the job. The same key always produces the
+// same schedule for a given expression.
+func WithHashKey(key string) ParserOption {
+	return func(p *Parser) {
+		p.hashKey = key
+	}
+}
+
+// WithWeekOfYear returns an option to expect a week of the year field after the
+// week days field, and before the optional year field. The weeks are numbered
+// according to ISO 8601 from 1 to 53.
+func WithWeekOfYear() ParserOption {
+	return func(p *Parser) {
+		p.weekOfYear = true
+	}
+}
+
+// WithDayOfYear returns an option to expect a day of the year field after the
+// week days field, or after the week of the year field when enabled, and
+// before the optional year field.
+func WithDayOfYear() ParserOption {
+	return func(p *Parser) {
+		p.dayOfYear = true
+	}
+}
+
+// NewParser returns a parser configured with the given options.
+func NewParser(opts ...ParserOption) Parser {
+	var p Parser
+	for _, opt := range opts {
+		opt(&p)
+	}
+	return p
+}
+
+// Parse returns a schedule from the Cron expression and returns an error if the
+// syntax is not supported or incorrect.
+func (p Parser) Parse(expression string) (schedule Schedule, err error) {
+	// The optional fields are placed after the week days and before the year.
+	optional := 0
+	if p.weekOfYear {
+		optional++
+	}
+	if p.dayOfYear {
+		optional++
+	}
 
-func (p cronParser) Parse(expression string) (schedule Schedule, err error) {
 	matches := strings.Split(expression, " ")
-	if len(matches) < minExprMatches || len(matches) > maxExprMatches {
+	if len(matches) < minExprMatches+optional || len(matches) > maxExprMatches+optional {
 		return schedule, ErrMalformedExpression
 	}
 
-	weekdays, err := p.parse(matches[5], convertWeekDay, rangeMinWeekday, rangeMaxWeekday)
+	weekdays, err := p.parseField(WeekDays, matches[5], convertWeekDay, rangeMinWeekday, rangeMaxWeekday)
 	if err != nil {
 		return schedule, newTimeUnitErr(WeekDays, err)
 	}
-	months, err := p.parse(matches[4], convertUnit, rangeMinMonth, rangeMaxMonth)
+	months, err := p.parseField(Months, matches[4], convertUnit, rangeMinMonth, rangeMaxMonth)
 	if err != nil {
 		return schedule, newTimeUnitErr(Months, err)
 	}
-	days, err := p.parse(matches[3], convertWithLastDayOfMonth, rangeMinDayOfMonth, rangeMaxDayOfMonth)
+	days, err := p.parseField(Days, matches[3], p.convertDay, rangeMinDayOfMonth, rangeMaxDayOfMonth)
 	if err != nil {
 		return schedule, newTimeUnitErr(Days, err)
 	}
-	hours, err := p.parse(matches[2], convertUnit, rangeMinHour, rangeMaxHour)
+	hours, err := p.parseField(Hours, matches[2], convertUnit, rangeMinHour, rangeMaxHour)
 	if err != nil {
 		return schedule, newTimeUnitErr(Hours, err)
 	}
-	minutes, err := p.parse(matches[1], convertUnit, rangeMinMinute, rangeMaxMinute)
+	minutes, err := p.parseField(Minutes, matches[1], convertUnit, rangeMinMinute, rangeMaxMinute)
 	if err != nil {
 		return schedule, newTimeUnitErr(Minutes, err)
 	}
-	seconds, err := p.parse(matches[0], convertUnit, rangeMinSecond, rangeMaxSecond)
+	seconds, err := p.parseField(Seconds, matches[0], convertUnit, rangeMinSecond, rangeMaxSecond)
 	if err != nil {
 		return schedule, newTimeUnitErr(Seconds, err)
 	}
@@ -76,8 +141,32 @@ func (p cronParser) Parse(expression string) (schedule Schedule, err error) {
 		secTimeUnit(seconds),
 	}
 
-	if len(matches) == maxExprMatches {
-		years, err := p.parse(matches[maxExprMatches-1], convertUnit, rangeMinYear, rangeMaxYear)
+	index := minExprMatches
+
+	if p.weekOfYear {
+		weeks, err := p.parseField(WeeksOfYear, matches[index], convertUnit, rangeMinWeekOfYear, rangeMaxWeekOfYear)
+		if err != nil {
+			return schedule, newTimeUnitErr(WeeksOfYear, err)
+		}
+
+		schedule.timeUnits = append(schedule.timeUnits, weekOfYearTimeUnit(continuousWeeks(weeks)))
+		index++
+	}
+
+	if p.dayOfYear {
+		yearDays, err := p.parseField(
+			DaysOfYear, matches[index], convertWithLastDayOfYear, rangeMinDayOfYear, rangeMaxDayOfYear,
+		)
+		if err != nil {
+			return schedule, newTimeUnitErr(DaysOfYear, err)
+		}
+
+		schedule.timeUnits = append(schedule.timeUnits, dayOfYearTimeUnit(yearDays))
+		index++
+	}
+
+	if len(matches) > index {
+		years, err := p.parseField(Years, matches[index], convertUnit, rangeMinYear, rangeMaxYear)
 		if err != nil {
 			return schedule, newTimeUnitErr(Years, err)
 		}
@@ -88,7 +177,20 @@ func (p cronParser) Parse(expression string) (schedule Schedule, err error) {
 	return schedule, err
 }
 
-func (cronParser) parse(expr string, convFn converterFn, min, max int) (fields []timeSet, err error) {
+// parseField returns the time sets of a field of the expression after the
+// hashed values have been resolved.
+func (p Parser) parseField(kind TimeUnitKind, expr string, convFn converterFn, min, max int) ([]timeSet, error) {
+	convFn = p.withTokens(kind, convFn)
+
+	expr, err := p.resolveHash(kind, expr, convFn, min, max)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.parse(expr, convFn, min, max)
+}
+
+func (Parser) parse(expr string, convFn converterFn, min, max int) (fields []timeSet, err error) {
 	if expr == "*" {
 		return nil, err
 	}
@@ -103,7 +205,7 @@ func (cronParser) parse(expr string, convFn converterFn, min, max int) (fields [
 		switch {
 		case strings.Contains(u, "/"):
 			field, err = parseInterval(u, convFn, min, max)
-		case strings.Contains(u, "-") && !strings.Contains(u, "L-"):
+		case isRange(u):
 			field, err = parseRange(u, convFn)
 		default:
 			field, err = convFn(u)
@@ -122,18 +224,40 @@ func (cronParser) parse(expr string, convFn converterFn, min, max int) (fields [
 	return fields, nil
 }
 
+// isRange returns true when the expression contains a range separator.
+func isRange(expr string) bool {
+	return rangeSeparator(expr) >= 0
+}
+
+// rangeSeparator returns the index of the `-` separating the bounds of a range,
+// or -1 if none exists. A `-` after `L`, `LB` or `#` is an offset which belongs
+// to a bound (e.g. `L-3-L` or `1-5#-1`).
+func rangeSeparator(expr string) int {
+	for i, r := range expr {
+		if r != '-' {
+			continue
+		}
+
+		prefix := expr[:i]
+		if !strings.HasSuffix(prefix, "L") && !strings.HasSuffix(prefix, "LB") && !strings.HasSuffix(prefix, "#") {
+			return i
+		}
+	}
+	return -1
+}
+
 func parseRange(expr string, convFn converterFn) (r rangeExpr, err error) {
-	parts := strings.Split(expr, "-")
-	if len(parts) != rangeSplitSize {
+	sep := rangeSeparator(expr)
+	if sep < 0 || isRange(expr[sep+1:]) {
 		err = ErrMalformedField
 		return
 	}
 
-	r.from, err = convFn(parts[0])
+	r.from, err = convFn(expr[:sep])
 	if err != nil {
 		return
 	}
-	r.to, err = convFn(parts[1])
+	r.to, err = convFn(expr[sep+1:])
 	if err != nil {
 		return
 	}
@@ -151,12 +275,16 @@ func parseInterval(expr string, convFn converterFn, min, max int) (i intervalExp
 	if err != nil {
 		return i, err
 	}
+	if i.incr < 1 {
+		return i, ErrMalformedField
+	}
 
 	switch {
 	case parts[0] == "*":
 		i.rge.from = unitExpr(min)
 		i.rge.to = unitExpr(max)
-	case strings.Contains(parts[0], "-"):
+		i.open = true
+	case isRange(parts[0]):
 		i.rge, err = parseRange(parts[0], convFn)
 		if err != nil {
 			return
@@ -168,6 +296,7 @@ func parseInterval(expr string, convFn converterFn, min, max int) (i intervalExp
 		}
 
 		i.rge.to = unitExpr(max)
+		i.open = true
 	}
 
 	return
@@ -224,26 +353,10 @@ type rangeExpr struct {
 }
 
 func (r rangeExpr) NearestCandidate(t time.Time, other int, forwards bool) (int, result) {
-	from, to := r.from, r.to
-	if !forwards {
-		from, to = to, from
-	}
-
-	// Check if the value lies after the maximum of the range which would mean a
-	// miss and the search must move to the next candidate.
-	if _, res := to.NearestCandidate(t, other, forwards); res == miss {
-		return other, miss
-	}
-
-	// Check if the value lies before the minimum of the range which means the
-	// nearest candidate is the beginning of the range.
-	value, res := from.NearestCandidate(t, other, forwards)
-	if res == inRange {
-		return value, inRange
-	}
-
-	// The value is inside the range.
-	return other, hit
+	// A range is an interval with a step of one so that the boundaries are
+	// evaluated the same way. A range where the beginning comes after the end
+	// (e.g. `L-3-28` in February) is empty.
+	return intervalExpr{rge: r, incr: 1}.NearestCandidate(t, other, forwards)
 }
 
 func (r rangeExpr) SubsetOf(min, max int) bool {
@@ -253,29 +366,40 @@ func (r rangeExpr) SubsetOf(min, max int) bool {
 type intervalExpr struct {
 	rge  rangeExpr
 	incr int
+	// open is true when the interval is not bounded by the expression (e.g.
+	// `*/2` or `5/2`) and ends at the maximum of the field.
+	open bool
 }
 
 func (i intervalExpr) NearestCandidate(t time.Time, other int, forwards bool) (int, result) {
-	// Get the beginning of the range.
+	// A bound which does not exist in the month of the time (e.g. `L-30` in
+	// February) leaves the range empty.
+	if isMissingDay(i.rge.from, t) || isMissingDay(i.rge.to, t) {
+		return other, miss
+	}
+
+	// Get the boundaries of the range which may depend on the time.
 	from, _ := i.rge.from.NearestCandidate(t, other, forwards)
+	to, _ := i.rge.to.NearestCandidate(t, other, forwards)
 
-	remainder := (other - from) % i.incr
-	value := other
-	if remainder > 0 {
-		value += i.incr - remainder
-	}
-	if !forwards {
-		value -= i.incr
+	var value int
+	if forwards {
+		// Round up to the next step starting from the beginning of the range.
+		value = max(other, from)
+		value += floorMod(from-value, i.incr)
+	} else {
+		// Round down to the previous step within the range.
+		value = min(other, to)
+		value -= floorMod(value-from, i.incr)
 	}
 
-	_, res := i.rge.NearestCandidate(t, value, forwards)
 	switch {
-	case res == hit && other == value:
+	case value < from || value > to:
+		return value, miss
+	case value == other:
 		return value, hit
-	case res == inRange || res == hit:
-		return max(from, value), inRange
 	default:
-		return value, miss
+		return value, inRange
 	}
 }
 
@@ -283,6 +407,22 @@ func (i intervalExpr) SubsetOf(min, max int) bool {
 	return i.rge.SubsetOf(min, max)
 }
 
+// floorDiv returns the quotient of the division rounded towards negative
+// infinity.
+func floorDiv[T int | int64](a, b T) T {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// floorMod returns the remainder of the division which has the sign of the
+// divisor.
+func floorMod(a, b int) int {
+	return a - floorDiv(a, b)*b
+}
+
 // nthLastDayOfMonthExpr is a specialized expression field to determine the last
 // day of a month.
 type nthLastDayOfMonthExpr struct {
@@ -290,15 +430,33 @@ type nthLastDayOfMonthExpr struct {
 }
 
 func (e nthLastDayOfMonthExpr) NearestCandidate(t time.Time, other int, forwards bool) (int, result) {
-	value := findLastDayOfMonth(t).Day() - e.nthLast
+	value := e.valueFor(t)
+	if value < 1 {
+		// The day does not exist in a short month (e.g. `L-31` in April).
+		return value, miss
+	}
+
 	_, res := unitExpr(value).NearestCandidate(t, other, forwards)
 	return value, res
 }
 
+// valueFor returns the day of the month of the time, which is below one when
+// the month is too short.
+func (e nthLastDayOfMonthExpr) valueFor(t time.Time) int {
+	return findLastDayOfMonth(t).Day() - e.nthLast
+}
+
 func (e nthLastDayOfMonthExpr) SubsetOf(min, max int) bool {
 	return e.nthLast+1 >= min && max-e.nthLast >= min
 }
 
+// isMissingDay returns true if the set is a day counted from the end of the
+// month which does not exist in the month of the time.
+func isMissingDay(set timeSet, t time.Time) bool {
+	e, ok := set.(nthLastDayOfMonthExpr)
+	return ok && e.valueFor(t) < 1
+}
+
 // lastWeekDayOfMonthExpr is a specialized expression field to determine which
 // day of the month corresponds to the last occurrence of a week day.
 type lastWeekDayOfMonthExpr struct {
@@ -332,30 +490,41 @@ func findLastDayOfMonth(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, -1)
 }
 
+// nthWeekdayOfMonthExpr is a specialized expression field to determine the nth
+// occurrence of a week day in a month, counted from the end of the month when
+// negative (e.g. -2 for the second last occurrence).
 type nthWeekdayOfMonthExpr struct {
 	weekday time.Weekday
 	nth     int
 }
 
 func (e nthWeekdayOfMonthExpr) NearestCandidate(t time.Time, _ int, forwards bool) (int, result) {
-	firstDayOfMonth := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	value := e.valueFor(t)
+	_, direction := unitExpr(value).NearestCandidate(t, t.Day(), forwards)
+	return value, direction
+}
 
-	diff := int(firstDayOfMonth.Weekday() - e.weekday)
-	if diff <= 0 {
-		// Add a week length to get a positive value. Note that here we also
-		// increment foor the zero value.
-		diff += int(time.Saturday) + 1
-	}
+func (e nthWeekdayOfMonthExpr) valueFor(t time.Time) int {
+	var value int
+	if e.nth < 0 {
+		value = lastWeekDayOfMonthExpr{weekday: e.weekday}.valueFor(t) + (e.nth+1)*daysInWeek
+	} else {
+		firstDayOfMonth := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
 
-	diff = -diff + e.nth*(int(time.Saturday+1))
+		diff := int(e.weekday-firstDayOfMonth.Weekday()+daysInWeek) % daysInWeek
+		value = 1 + diff + (e.nth-1)*daysInWeek
+	}
 
-	value := firstDayOfMonth.AddDate(0, 0, diff).Day()
-	_, direction := unitExpr(value).NearestCandidate(t, t.Day(), forwards)
-	return value, direction
+	if value < 1 || value > findLastDayOfMonth(t).Day() {
+		// The occurrence does not exist in this month.
+		return 0
+	}
+	return value
 }
 
 func (e nthWeekdayOfMonthExpr) SubsetOf(min, max int) bool {
-	return e.nth >= 1 && e.nth <= 5 && int(e.weekday) >= min && int(e.weekday) <= max
+	return e.nth != 0 && e.nth >= -maxWeekdaysInMonth && e.nth <= maxWeekdaysInMonth &&
+		int(e.weekday) >= min && int(e.weekday) <= max
 }
 
 var weekdays = map[string]time.Weekday{
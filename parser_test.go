@@ -93,6 +93,11 @@ func TestParser_Parse_abortsOnMalformedYears(t *testing.T) {
 	requireSameKind(t, e.kind, Years)
 }
 
+func TestParser_Parse_abortsOnZeroIncrement(t *testing.T) {
+	_, err := defaultParser.Parse("*/0 * * * * *")
+	requireErrorIs(t, err, ErrMalformedField)
+}
+
 func TestParser_Parse_abortsOnTooBigLastValue(t *testing.T) {
 	_, err := defaultParser.Parse("* * * L-32 * *")
 	requireErrorIs(t, err, ErrValueOutsideRange)
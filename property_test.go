@@ -0,0 +1,169 @@
+package gocron
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// dayPredicate returns true when a day matches an expression. It is the
+// brute-force evaluation of an expression.
+type dayPredicate func(day time.Time) bool
+
+func TestSchedule_Next_matchesBruteForceDays(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 300; i++ {
+		expr, pred := randomDaysField(rnd)
+		testBruteForce(t, rnd, "0 0 0 "+expr+" * ?", pred)
+	}
+}
+
+func TestSchedule_Next_matchesBruteForceWeekdays(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+
+	for i := 0; i < 300; i++ {
+		expr, pred := randomWeekdaysField(rnd)
+		testBruteForce(t, rnd, "0 0 0 ? * "+expr, pred)
+	}
+}
+
+func testBruteForce(t *testing.T, rnd *rand.Rand, expr string, pred dayPredicate) {
+	t.Helper()
+
+	schedule, err := Parse(expr)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", expr, err)
+	}
+
+	from := time.Date(2000+rnd.Intn(50), time.Month(1+rnd.Intn(12)), 1+rnd.Intn(28), 12, 0, 0, 0, time.UTC)
+
+	expect := bruteForce(from, 1, pred)
+	if next := schedule.Next(from); !next.Equal(expect) {
+		t.Fatalf("%s: next after %v: %v != %v", expr, from, next, expect)
+	}
+
+	expect = bruteForce(from, -1, pred)
+	if prev := schedule.Previous(from); !prev.Equal(expect) {
+		t.Fatalf("%s: previous before %v: %v != %v", expr, from, prev, expect)
+	}
+}
+
+// bruteForce returns the midnight of the first day matching the predicate in
+// the direction, or a zero time if none is found within a few years.
+func bruteForce(from time.Time, direction int, pred dayPredicate) time.Time {
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	if direction > 0 {
+		day = day.AddDate(0, 0, 1)
+	}
+
+	for i := 0; i < 366*8; i++ {
+		if pred(day) {
+			return day
+		}
+		day = day.AddDate(0, 0, direction)
+	}
+	return time.Time{}
+}
+
+func randomDaysField(rnd *rand.Rand) (string, dayPredicate) {
+	var exprs []string
+	var preds []dayPredicate
+
+	for n := 1 + rnd.Intn(2); n > 0; n-- {
+		expr, pred := randomDaysItem(rnd)
+		exprs = append(exprs, expr)
+		preds = append(preds, pred)
+	}
+
+	return strings.Join(exprs, ","), anyOf(preds)
+}
+
+func randomDaysItem(rnd *rand.Rand) (string, dayPredicate) {
+	// A bound is either a day or a day counted from the end of the month.
+	bound := func() (string, func(time.Time) int) {
+		if rnd.Intn(2) == 0 {
+			day := 1 + rnd.Intn(31)
+			return strconv.Itoa(day), func(time.Time) int { return day }
+		}
+		if nth := rnd.Intn(32); nth > 0 {
+			return "L-" + strconv.Itoa(nth), func(t time.Time) int { return findLastDayOfMonth(t).Day() - nth + 1 }
+		}
+		return "L", func(t time.Time) int { return findLastDayOfMonth(t).Day() }
+	}
+
+	from, fromFn := bound()
+
+	kind := rnd.Intn(4)
+	if from == "L" && (kind == 1 || kind == 3) {
+		// `L-n` is the nth last day and not a range, which is written `L-1-n`
+		// instead.
+		from = "L-1"
+	}
+
+	switch kind {
+	case 0:
+		return from, func(t time.Time) bool { return t.Day() == fromFn(t) }
+	case 1:
+		// A range is empty in a month too short for one of its bounds.
+		to, toFn := bound()
+		return from + "-" + to, func(t time.Time) bool {
+			return fromFn(t) >= 1 && toFn(t) >= 1 && t.Day() >= fromFn(t) && t.Day() <= toFn(t)
+		}
+	case 2:
+		incr := 1 + rnd.Intn(10)
+		return from + "/" + strconv.Itoa(incr), func(t time.Time) bool {
+			return fromFn(t) >= 1 && t.Day() >= fromFn(t) && (t.Day()-fromFn(t))%incr == 0
+		}
+	default:
+		to, toFn := bound()
+		incr := 1 + rnd.Intn(10)
+		return from + "-" + to + "/" + strconv.Itoa(incr), func(t time.Time) bool {
+			return fromFn(t) >= 1 && toFn(t) >= 1 && t.Day() >= fromFn(t) && t.Day() <= toFn(t) &&
+				(t.Day()-fromFn(t))%incr == 0
+		}
+	}
+}
+
+func randomWeekdaysField(rnd *rand.Rand) (string, dayPredicate) {
+	var exprs []string
+	var preds []dayPredicate
+
+	for n := 1 + rnd.Intn(2); n > 0; n-- {
+		weekday := time.Weekday(rnd.Intn(7))
+		nth := 1 + rnd.Intn(maxWeekdaysInMonth)
+
+		switch rnd.Intn(3) {
+		case 0:
+			exprs = append(exprs, strconv.Itoa(int(weekday))+"#"+strconv.Itoa(nth))
+			preds = append(preds, func(t time.Time) bool {
+				return t.Weekday() == weekday && (t.Day()-1)/daysInWeek+1 == nth
+			})
+		case 1:
+			exprs = append(exprs, strconv.Itoa(int(weekday))+"#-"+strconv.Itoa(nth))
+			preds = append(preds, func(t time.Time) bool {
+				return t.Weekday() == weekday && (findLastDayOfMonth(t).Day()-t.Day())/daysInWeek+1 == nth
+			})
+		default:
+			exprs = append(exprs, strconv.Itoa(int(weekday))+"L")
+			preds = append(preds, func(t time.Time) bool {
+				return t.Weekday() == weekday && findLastDayOfMonth(t).Day()-t.Day() < daysInWeek
+			})
+		}
+	}
+
+	return strings.Join(exprs, ","), anyOf(preds)
+}
+
+func anyOf(preds []dayPredicate) dayPredicate {
+	return func(t time.Time) bool {
+		for _, pred := range preds {
+			if pred(t) {
+				return true
+			}
+		}
+		return false
+	}
+}
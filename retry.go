@@ -0,0 +1,97 @@
+package gocron
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	// defaultInitialBackoff is the delay before the first retry when the
+	// policy does not define one.
+	defaultInitialBackoff = time.Second
+
+	// defaultBackoffMultiplier is the factor applied to the delay after each
+	// retry when the policy does not define one.
+	defaultBackoffMultiplier = 2
+)
+
+// RetryPolicy defines how a failed run of a job is retried. The delay between
+// two attempts grows exponentially from the initial backoff up to the maximum
+// backoff.
+//
+// A retry only starts before the next activation of the schedule, otherwise the
+// run ends with the error of the last attempt and the next activation runs
+// normally. The context of a retry is canceled at the next activation so that
+// it never overlaps with the next run, and a retry waiting for its backoff is
+// abandoned when the scheduler stops.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a run including the
+	// first one. A value of one or less disables the retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, which is one second
+	// by default.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum delay between two attempts, or unlimited when
+	// zero.
+	MaxBackoff time.Duration
+	// Multiplier is the factor applied to the delay after each retry, which is
+	// two by default.
+	Multiplier float64
+	// Jitter is the fraction of the delay between 0 and 1 which is randomly
+	// removed from it so that jobs failing together do not retry together.
+	Jitter float64
+	// RetryOn is the list of errors which are retried, matched with errors.Is.
+	// Any error is retried when the list is empty.
+	RetryOn []error
+}
+
+// WithRetryPolicy returns an option to set the retry policy of a job. Failed
+// runs are not retried by default.
+func WithRetryPolicy(policy RetryPolicy) JobOption {
+	return func(e *entry) {
+		e.retry = policy
+	}
+}
+
+// retries returns true if the error of the nth attempt of a run is retried.
+func (p RetryPolicy) retries(err error, attempt int) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+	if len(p.RetryOn) == 0 {
+		return true
+	}
+	for _, target := range p.RetryOn {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay after the nth attempt of a run.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = defaultInitialBackoff
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = defaultBackoffMultiplier
+	}
+
+	delay := float64(initial) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 {
+		delay = math.Min(delay, float64(p.MaxBackoff))
+	}
+	if jitter := math.Min(math.Max(p.Jitter, 0), 1); jitter > 0 {
+		delay -= delay * jitter * rand.Float64()
+	}
+	if delay >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+
+	return time.Duration(delay)
+}
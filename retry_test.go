@@ -0,0 +1,273 @@
+package gocron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	vectors := []struct {
+		policy  RetryPolicy
+		expects []time.Duration
+	}{
+		{
+			policy:  RetryPolicy{},
+			expects: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			policy:  RetryPolicy{InitialBackoff: time.Minute, Multiplier: 3},
+			expects: []time.Duration{time.Minute, 3 * time.Minute, 9 * time.Minute},
+		},
+		{
+			policy:  RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second},
+			expects: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second},
+		},
+	}
+
+	for _, v := range vectors {
+		for i, expect := range v.expects {
+			if d := v.policy.backoff(i + 1); d != expect {
+				t.Fatalf("%+v attempt %d: %v != %v", v.policy, i+1, d, expect)
+			}
+		}
+	}
+
+	if d := (RetryPolicy{}).backoff(100); d != time.Duration(1<<63-1) {
+		t.Fatalf("unexpected backoff: %v", d)
+	}
+}
+
+func TestRetryPolicy_backoff_jitter(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 10 * time.Second, Jitter: 0.5}
+
+	for i := 0; i < 100; i++ {
+		if d := policy.backoff(1); d < 5*time.Second || d > 10*time.Second {
+			t.Fatalf("backoff out of range: %v", d)
+		}
+	}
+}
+
+func TestRetryPolicy_retries(t *testing.T) {
+	errTemporary := errors.New("temporary")
+	errOther := errors.New("other")
+
+	policy := RetryPolicy{MaxAttempts: 3, RetryOn: []error{errTemporary}}
+
+	vectors := []struct {
+		err     error
+		attempt int
+		expect  bool
+	}{
+		{err: errTemporary, attempt: 1, expect: true},
+		{err: fmt.Errorf("wrapped: %w", errTemporary), attempt: 2, expect: true},
+		{err: errTemporary, attempt: 3, expect: false},
+		{err: errOther, attempt: 1, expect: false},
+	}
+
+	for _, v := range vectors {
+		if policy.retries(v.err, v.attempt) != v.expect {
+			t.Fatalf("%v attempt %d: expected %t", v.err, v.attempt, v.expect)
+		}
+	}
+
+	if (RetryPolicy{}).retries(errOther, 1) {
+		t.Fatal("expected no retry by default")
+	}
+	if !(RetryPolicy{MaxAttempts: 2}).retries(errOther, 1) {
+		t.Fatal("expected any error to be retried")
+	}
+}
+
+func TestScheduler_retriesFailedRuns(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 59, 0, 0, time.UTC))
+	errTemporary := errors.New("temporary")
+
+	errs := make(chan error, 1)
+	s := NewScheduler(WithClock(clock), WithErrorHandler(func(name string, err error) {
+		errs <- err
+	}))
+
+	n := 0
+	attempts := make(chan time.Time, 10)
+	requireNoError(t, s.Add("job", MustParse("0 0 * * * ?"), func(context.Context) error {
+		attempts <- clock.Now()
+		if n++; n < 3 {
+			return errTemporary
+		}
+		return nil
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Minute, RetryOn: []error{errTemporary}})))
+
+	s.Start()
+	defer func() { requireNoError(t, s.Stop(context.Background())) }()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	requireRun(t, attempts, time.Date(2023, time.June, 4, 11, 0, 0, 0, time.UTC))
+
+	// The loop, the deadline of the retries and the backoff are waiting.
+	clock.BlockUntil(3)
+	clock.Advance(time.Minute)
+	requireRun(t, attempts, time.Date(2023, time.June, 4, 11, 1, 0, 0, time.UTC))
+
+	clock.BlockUntil(3)
+	clock.Advance(2 * time.Minute)
+	requireRun(t, attempts, time.Date(2023, time.June, 4, 11, 3, 0, 0, time.UTC))
+
+	waitIdle(t, s, "job")
+
+	if stats, _ := s.Stats("job"); stats != (JobStats{Runs: 1, Retries: 2}) {
+		t.Fatalf("unexpected statistics: %+v", stats)
+	}
+	select {
+	case err := <-errs:
+		t.Fatalf("unexpected error: %v", err)
+	default:
+	}
+}
+
+func TestScheduler_retryBeforeNextActivation(t *testing.T) {
+	vectors := []struct {
+		name    string
+		policy  RetryPolicy
+		retries uint64
+	}{
+		{
+			name:    "attempts exhausted",
+			policy:  RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second},
+			retries: 2,
+		},
+		{
+			name:    "next activation first",
+			policy:  RetryPolicy{MaxAttempts: 3, InitialBackoff: 20 * time.Second},
+			retries: 1,
+		},
+		{
+			name:    "not retryable",
+			policy:  RetryPolicy{MaxAttempts: 3, RetryOn: []error{context.DeadlineExceeded}},
+			retries: 0,
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.name, func(t *testing.T) {
+			clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 59, 30, 0, time.UTC))
+			errFailure := errors.New("failure")
+
+			errs := make(chan error, 1)
+			s := NewScheduler(WithClock(clock), WithErrorHandler(func(name string, err error) {
+				errs <- err
+			}))
+
+			requireNoError(t, s.Add("job", MustParse("*/30 * * * * ?"), func(context.Context) error {
+				return errFailure
+			}, WithRetryPolicy(v.policy)))
+
+			s.Start()
+			defer func() { requireNoError(t, s.Stop(context.Background())) }()
+
+			// Retries are driven second by second until the run reports its
+			// error, which happens before the next activation at 11:00:30.
+			clock.BlockUntil(1)
+			clock.Advance(30 * time.Second)
+			for i := 0; i < 29; i++ {
+				select {
+				case err := <-errs:
+					requireErrorIs(t, err, errFailure)
+					if stats, _ := s.Stats("job"); stats != (JobStats{Runs: 1, Retries: v.retries}) {
+						t.Fatalf("unexpected statistics: %+v", stats)
+					}
+					return
+				case <-time.After(10 * time.Millisecond):
+					clock.Advance(time.Second)
+				}
+			}
+			t.Fatal("expected an error before the next activation")
+		})
+	}
+}
+
+func TestScheduler_retryEndsAtNextActivation(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 59, 0, 0, time.UTC))
+	errTemporary := errors.New("temporary")
+
+	errs := make(chan error, 1)
+	s := NewScheduler(WithClock(clock), WithErrorHandler(func(name string, err error) {
+		errs <- err
+	}))
+
+	var n atomic.Int32
+	deadlines := make(chan time.Time, 2)
+	requireNoError(t, s.Add("job", MustParse("0 0 * * * ?"), func(ctx context.Context) error {
+		switch n.Add(1) {
+		case 1:
+			return errTemporary
+		case 2:
+			deadline, _ := ctx.Deadline()
+			deadlines <- deadline
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		// The run of the next activation succeeds.
+		return nil
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Minute})))
+
+	s.Start()
+	defer func() { requireNoError(t, s.Stop(context.Background())) }()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	clock.BlockUntil(3)
+	clock.Advance(time.Minute)
+
+	next := time.Date(2023, time.June, 4, 12, 0, 0, 0, time.UTC)
+	requireRun(t, deadlines, next)
+
+	clock.Advance(next.Sub(clock.Now()))
+
+	select {
+	case err := <-errs:
+		requireErrorIs(t, err, context.DeadlineExceeded)
+	case <-time.After(time.Second):
+		t.Fatal("expected the retry to end")
+	}
+}
+
+func TestScheduler_Stop_abandonsRetries(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 59, 0, 0, time.UTC))
+	errTemporary := errors.New("temporary")
+
+	errs := make(chan error, 1)
+	s := NewScheduler(WithClock(clock), WithErrorHandler(func(name string, err error) {
+		errs <- err
+	}))
+
+	requireNoError(t, s.Add("job", MustParse("0 0 * * * ?"), func(context.Context) error {
+		return errTemporary
+	}, WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Minute})))
+
+	s.Start()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	clock.BlockUntil(3)
+
+	// The run ends with the error of the first attempt without waiting for
+	// the backoff.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	requireNoError(t, s.Stop(ctx))
+
+	select {
+	case err := <-errs:
+		requireErrorIs(t, err, errTemporary)
+	default:
+		t.Fatal("expected the error of the last attempt")
+	}
+	if stats, _ := s.Stats("job"); stats != (JobStats{Runs: 1}) {
+		t.Fatalf("unexpected statistics: %+v", stats)
+	}
+}
@@ -0,0 +1,777 @@
+package gocron
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRDates is the maximum number of activation times that can be listed when
+// a schedule cannot be expressed as a recurrence rule.
+const (
+	maxRDates  = 10000
+	daysInWeek = 7
+)
+
+var rruleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// rruleFreq is the frequency of a recurrence rule.
+type rruleFreq int
+
+const (
+	secondly rruleFreq = iota
+	minutely
+	hourly
+	daily
+	weekly
+	monthly
+	yearly
+)
+
+var rruleFrequencies = []string{"SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+func (f rruleFreq) String() string {
+	return rruleFrequencies[int(f)%len(rruleFrequencies)]
+}
+
+// ToRRule returns the RFC 5545 representation of the schedule starting at the
+// first activation after `from`, which is a `DTSTART` line followed by either a
+// `RRULE` line, or a `RDATE` line listing the activation times up to `until`
+// when the schedule cannot be expressed as a rule. A zero `until` means the
+// rule has no end, in which case an error is returned if a rule cannot be
+// produced. The end of the window of the schedule is used when it comes
+// before `until`, and a limited number of activations is exported as a count
+// when the rule starts at the first one.
+func (s Schedule) ToRRule(from, until time.Time) (string, error) {
+	window := s.window()
+	if !window.end.IsZero() && (until.IsZero() || !window.end.After(until)) {
+		until = window.end
+	} else {
+		window.count = 0
+	}
+
+	start := s.Next(from)
+	if start.IsZero() || (!until.IsZero() && start.After(until)) {
+		return "", ErrNoActivation
+	}
+
+	// A rule is evaluated in the wall clock of its start, which must be the one
+	// of a named time zone all year round.
+	year := make([]time.Time, rangeMaxMonth)
+	for i := range year {
+		year[i] = start.AddDate(0, i, 0)
+	}
+
+	rule, ok := s.rrule()
+	if ok && (start.Location() == time.UTC || isZoneAt(start.Location(), year...)) {
+		switch {
+		case window.count > 0 && start.Equal(s.Next(window.start.Add(-time.Second))):
+			rule += ";COUNT=" + strconv.Itoa(window.count)
+		case !until.IsZero():
+			rule += ";UNTIL=" + until.UTC().Format(icalUTCLayout)
+		}
+
+		return formatICalTime("DTSTART", start) + "\nRRULE:" + rule, nil
+	}
+
+	if until.IsZero() {
+		return "", ErrNotExpressible
+	}
+
+	dates := []time.Time{start}
+	for iter := s.Upcoming(start); iter.HasNext(); {
+		next := iter.Next()
+		if next.After(until) {
+			break
+		}
+		if len(dates) == maxRDates {
+			return "", fmt.Errorf("%w: more than %d activations", ErrNotExpressible, maxRDates)
+		}
+
+		dates = append(dates, next)
+	}
+
+	// The start is written in UTC too when any of the dates is.
+	if !isZoneAt(start.Location(), dates...) {
+		for i := range dates {
+			dates[i] = dates[i].UTC()
+		}
+		start = start.UTC()
+	}
+
+	return formatICalTime("DTSTART", start) + "\n" + formatICalTime("RDATE", dates...), nil
+}
+
+// rrule returns the recurrence rule, without its bounds, that is equivalent to
+// the time units of the schedule, or false if it does not exist.
+func (s Schedule) rrule() (string, bool) {
+	type part struct {
+		key    string
+		values []string
+	}
+
+	var months, days, weekdays, hours, minutes, seconds []string
+	var hasOrdinal bool
+
+	for _, unit := range s.timeUnits {
+		var ok bool
+
+		switch u := unit.(type) {
+		case windowTimeUnit:
+			// The start is the first activation and the end is the bound of
+			// the rule.
+			ok = true
+		case yearTimeUnit:
+			// A recurrence rule cannot limit the years.
+			ok = len(u) == 0
+		case monthTimeUnit:
+			months, ok = expandRRuleValues(u, rangeMinMonth, rangeMaxMonth)
+		case dayTimeUnit:
+			days, ok = expandRRuleMonthDays(u)
+		case weekdayTimeUnit:
+			weekdays, hasOrdinal, ok = expandRRuleWeekdays(u)
+		case hourTimeUnit:
+			hours, ok = expandRRuleValues(u, rangeMinHour, rangeMaxHour)
+		case minTimeUnit:
+			minutes, ok = expandRRuleValues(u, rangeMinMinute, rangeMaxMinute)
+		case secTimeUnit:
+			seconds, ok = expandRRuleValues(u, rangeMinSecond, rangeMaxSecond)
+		}
+
+		if !ok {
+			return "", false
+		}
+	}
+
+	// The frequency is the smallest time unit which is not constrained by the
+	// schedule. Constrained units then expand or limit the recurrence.
+	var freq rruleFreq
+	switch {
+	case seconds == nil:
+		freq = secondly
+	case minutes == nil:
+		freq = minutely
+	case hours == nil:
+		freq = hourly
+	case days == nil && !hasOrdinal:
+		freq = daily
+	case months == nil:
+		freq = monthly
+	default:
+		freq = yearly
+	}
+
+	if hasOrdinal && freq < monthly {
+		// Ordinal week days are only allowed for those two frequencies.
+		return "", false
+	}
+
+	parts := []part{
+		{key: "BYMONTH", values: months},
+		{key: "BYMONTHDAY", values: days},
+		{key: "BYDAY", values: weekdays},
+		{key: "BYHOUR", values: hours},
+		{key: "BYMINUTE", values: minutes},
+		{key: "BYSECOND", values: seconds},
+	}
+
+	var b strings.Builder
+	b.WriteString("FREQ=" + freq.String())
+	for _, p := range parts {
+		if p.values != nil {
+			b.WriteString(";" + p.key + "=" + strings.Join(p.values, ","))
+		}
+	}
+
+	return b.String(), true
+}
+
+// expandRRuleValues returns the list of values that the time sets include
+// within [min, max], or nil if the time sets include all of them. It returns
+// false when a time set depends on the date.
+func expandRRuleValues(in []timeSet, min, max int) ([]string, bool) {
+	if len(in) == 0 || isNotSpecified(in) {
+		return nil, true
+	}
+
+	values := []string{}
+	for v := min; v <= max; v++ {
+		for _, set := range in {
+			if !isStaticTimeSet(set) {
+				return nil, false
+			}
+			if _, res := set.NearestCandidate(time.Time{}, v, true); res == hit {
+				values = append(values, strconv.Itoa(v))
+				break
+			}
+		}
+	}
+
+	return values, true
+}
+
+// expandRRuleMonthDays returns the list of values for the `BYMONTHDAY` part,
+// or false if it does not exist.
+func expandRRuleMonthDays(in dayTimeUnit) ([]string, bool) {
+	var static []timeSet
+	var last []string
+
+	for _, set := range in {
+		switch v := set.(type) {
+		case nthLastDayOfMonthExpr:
+			last = append(last, strconv.Itoa(-v.nthLast-1))
+		case rangeExpr:
+			// A range up to the last day of the month is equivalent to a range
+			// up to 31 as the recurrence rule ignores invalid dates.
+			if to, ok := v.to.(nthLastDayOfMonthExpr); ok && to.nthLast == 0 {
+				v.to = unitExpr(rangeMaxDayOfMonth)
+			}
+			static = append(static, v)
+		default:
+			static = append(static, set)
+		}
+	}
+
+	values, ok := expandRRuleValues(static, rangeMinDayOfMonth, rangeMaxDayOfMonth)
+	if !ok {
+		return nil, false
+	}
+	if len(last) > 0 {
+		values = append(values, last...)
+	}
+	return values, true
+}
+
+// expandRRuleWeekdays returns the list of values for the `BYDAY` part, and
+// true when a value uses an ordinal (e.g. `-1FR`), or false if it does not
+// exist.
+func expandRRuleWeekdays(in weekdayTimeUnit) (values []string, hasOrdinal, ok bool) {
+	var static []timeSet
+
+	for _, set := range in {
+		switch v := set.(type) {
+		case lastWeekDayOfMonthExpr:
+			values = append(values, "-1"+rruleWeekdays[v.weekday])
+			hasOrdinal = true
+		case nthWeekdayOfMonthExpr:
+			values = append(values, strconv.Itoa(v.nth)+rruleWeekdays[v.weekday])
+			hasOrdinal = true
+		default:
+			static = append(static, set)
+		}
+	}
+
+	nums, ok := expandRRuleValues(static, rangeMinWeekday, rangeMaxWeekday)
+	if !ok {
+		return nil, false, false
+	}
+	for _, num := range nums {
+		weekday, _ := strconv.Atoi(num)
+		values = append(values, rruleWeekdays[weekday])
+	}
+
+	return values, hasOrdinal, true
+}
+
+// isStaticTimeSet returns true when the values of the time set do not depend on
+// the date.
+func isStaticTimeSet(set timeSet) bool {
+	switch v := set.(type) {
+	case unitExpr, notSpecifiedExpr:
+		return true
+	case rangeExpr:
+		return isStaticTimeSet(v.from) && isStaticTimeSet(v.to)
+	case intervalExpr:
+		return isStaticTimeSet(v.rge)
+	default:
+		return false
+	}
+}
+
+// formatICalTime returns an iCalendar property listing the given times. UTC
+// times use the UTC form while the others refer to the name of their location.
+func formatICalTime(name string, times ...time.Time) string {
+	if len(times) == 0 {
+		return name + ":"
+	}
+
+	loc := times[0].Location()
+	layout := icalDateTimeLayout
+	if loc == time.UTC || !isZoneAt(loc, times...) {
+		// Times in a location that consumers cannot resolve (e.g. time.Local),
+		// or resolve to other offsets, are written in UTC.
+		loc = time.UTC
+		layout = icalUTCLayout
+	} else {
+		name += ";TZID=" + loc.String()
+	}
+
+	values := make([]string, len(times))
+	for i, t := range times {
+		values[i] = t.In(loc).Format(layout)
+	}
+
+	return name + ":" + strings.Join(values, ",")
+}
+
+// isZoneAt returns true if the location has a name from the IANA time zone
+// database, which excludes time.Local and unnamed fixed zones, and if the zone
+// of that name has the offsets of the location at the given times.
+func isZoneAt(loc *time.Location, times ...time.Time) bool {
+	name := loc.String()
+	if name == "" || name == "Local" {
+		return false
+	}
+
+	zone, err := time.LoadLocation(name)
+	if err != nil {
+		return false
+	}
+
+	for _, t := range times {
+		_, offset := t.In(loc).Zone()
+		if _, other := t.In(zone).Zone(); other != offset {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseRRule returns a schedule from an RFC 5545 recurrence. The expression is
+// either a bare rule (e.g. `FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=0`) or content lines
+// with a `DTSTART` property followed by a `RRULE` or a `RDATE` property, as
+// produced by Schedule.ToRRule.
+//
+// The parts of the time not specified by the rule are taken from the start.
+// When the start is missing, the hours, minutes and seconds default to zero
+// while a rule that needs the start for other parts is refused. Floating times
+// are interpreted as UTC, while a start in a specific location makes the
+// schedule evaluate and return the activation times in that location.
+func ParseRRule(expression string) (Schedule, error) {
+	var rule string
+	var start icalTime
+	var dates []time.Time
+
+	for _, line := range strings.Split(expression, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if !strings.Contains(line, ":") {
+			rule = line
+			continue
+		}
+
+		name, params, value, err := parseICalContentLine(line)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("%w: %q", ErrMalformedRecurrence, line)
+		}
+
+		switch name {
+		case "RRULE":
+			rule = value
+		case "DTSTART":
+			start, err = parseICalTime(params, value)
+		case "RDATE":
+			dates, err = parseICalTimes(params, value)
+		default:
+			return Schedule{}, fmt.Errorf("%w: property %s", ErrUnsupportedRecurrence, name)
+		}
+
+		if err != nil {
+			return Schedule{}, fmt.Errorf("%w: %w", ErrMalformedRecurrence, err)
+		}
+	}
+
+	switch {
+	case rule != "" && dates != nil:
+		return Schedule{}, fmt.Errorf("%w: rule combined with dates", ErrUnsupportedRecurrence)
+	case dates != nil:
+		// The start is always the first activation of a recurrence.
+		if !start.IsZero() {
+			dates = append(dates, start.Time)
+		}
+
+		slices.SortFunc(dates, time.Time.Compare)
+		dates = slices.CompactFunc(dates, time.Time.Equal)
+		return Schedule{timeUnits: []TimeUnit{datesTimeUnit(dates)}}, nil
+	case rule == "":
+		return Schedule{}, fmt.Errorf("%w: missing rule", ErrMalformedRecurrence)
+	}
+
+	schedule, err := compileRRule(rule, start.Time)
+	if err != nil || start.IsZero() || start.floating {
+		return schedule, err
+	}
+
+	// The rule is evaluated in the location of the start.
+	schedule.timeUnits = append([]TimeUnit{locationTimeUnit{loc: start.Location()}}, schedule.timeUnits...)
+	return schedule, nil
+}
+
+// compileRRule returns a schedule with the time units equivalent to the
+// recurrence rule and its start.
+//
+//nolint:funlen,gocyclo // the parts of the rule are handled one after another.
+func compileRRule(rule string, start time.Time) (schedule Schedule, err error) {
+	parts, err := splitRRuleParts(strings.ToUpper(rule))
+	if err != nil {
+		return
+	}
+
+	freq := rruleFreq(-1)
+	interval := 1
+	count := 0
+	wkst := time.Monday
+	var until time.Time
+	var months, days, weekdays, hours, minutes, seconds []timeSet
+	var hasOrdinal bool
+
+	for key, value := range parts {
+		switch key {
+		case "FREQ":
+			if i := slices.Index(rruleFrequencies, value); i >= 0 {
+				freq = rruleFreq(i)
+			}
+		case "INTERVAL":
+			interval, err = parseRRulePositive(value)
+		case "COUNT":
+			count, err = parseRRulePositive(value)
+		case "UNTIL":
+			var t icalTime
+			t, err = parseICalTime(nil, value)
+			until = t.Time
+			if t.floating && !start.IsZero() {
+				until = inLocation(until, start.Location())
+			}
+		case "WKST":
+			i := slices.Index(rruleWeekdays, value)
+			if i < 0 {
+				err = ErrMalformedRecurrence
+			}
+			wkst = time.Weekday(i)
+		case "BYMONTH":
+			months, err = parseRRuleList(value, rangeMinMonth, rangeMaxMonth)
+		case "BYMONTHDAY":
+			days, err = parseRRuleMonthDays(value)
+		case "BYDAY":
+			weekdays, hasOrdinal, err = parseRRuleWeekdays(value)
+		case "BYHOUR":
+			hours, err = parseRRuleList(value, rangeMinHour, rangeMaxHour)
+		case "BYMINUTE":
+			minutes, err = parseRRuleList(value, rangeMinMinute, rangeMaxMinute)
+		case "BYSECOND":
+			seconds, err = parseRRuleList(value, rangeMinSecond, rangeMaxSecond)
+		default:
+			err = ErrUnsupportedRecurrence
+		}
+
+		if err != nil {
+			return schedule, fmt.Errorf("rule part %s: %w", key, err)
+		}
+	}
+
+	switch {
+	case freq < 0:
+		return schedule, fmt.Errorf("%w: missing frequency", ErrMalformedRecurrence)
+	case hasOrdinal && freq == yearly && months == nil:
+		return schedule, fmt.Errorf("%w: ordinal week days within a year", ErrUnsupportedRecurrence)
+	case hasOrdinal && freq < monthly:
+		return schedule, fmt.Errorf("%w: ordinal week days with %s frequency", ErrMalformedRecurrence, freq)
+	case start.IsZero() && (interval > 1 || count > 0):
+		return schedule, fmt.Errorf("%w: interval and count require a start", ErrMalformedRecurrence)
+	}
+
+	// Parts bigger than the frequency which are not specified take the value
+	// of the start.
+	fromStart := func(get func(time.Time) int) ([]timeSet, error) {
+		if start.IsZero() {
+			return nil, fmt.Errorf("%w: %s frequency requires a start", ErrMalformedRecurrence, freq)
+		}
+		return []timeSet{unitExpr(get(start))}, nil
+	}
+
+	if months == nil && days == nil && weekdays == nil && freq == yearly {
+		months, err = fromStart(func(t time.Time) int { return int(t.Month()) })
+	}
+	if err == nil && days == nil && weekdays == nil && freq >= monthly {
+		days, err = fromStart(time.Time.Day)
+	}
+	if err == nil && weekdays == nil && freq == weekly {
+		weekdays, err = fromStart(func(t time.Time) int { return int(t.Weekday()) })
+	}
+	if err != nil {
+		return
+	}
+
+	if hours == nil && freq > hourly {
+		hours = []timeSet{unitExpr(start.Hour())}
+	}
+	if minutes == nil && freq > minutely {
+		minutes = []timeSet{unitExpr(start.Minute())}
+	}
+	if seconds == nil && freq > secondly {
+		seconds = []timeSet{unitExpr(start.Second())}
+	}
+
+	if !start.IsZero() || !until.IsZero() {
+		schedule.timeUnits = append(schedule.timeUnits, windowTimeUnit{start: start, end: until})
+	}
+	if interval > 1 {
+		schedule.timeUnits = append(schedule.timeUnits,
+			periodTimeUnit{freq: freq, interval: interval, anchor: start, wkst: wkst})
+	}
+
+	schedule.timeUnits = append(schedule.timeUnits,
+		monthTimeUnit(months),
+		dayTimeUnit(days),
+		weekdayTimeUnit(weekdays),
+		hourTimeUnit(hours),
+		minTimeUnit(minutes),
+		secTimeUnit(seconds),
+	)
+
+	if count > 0 {
+		// The window ends with the last activation allowed by the count.
+		var last time.Time
+		iter := schedule.Upcoming(start.Add(-time.Second))
+		for i := 0; i < count && iter.HasNext(); i++ {
+			last = iter.Next()
+		}
+
+		schedule.timeUnits[0] = windowTimeUnit{start: start, end: last, count: count}
+	}
+
+	return
+}
+
+func parseRRulePositive(value string) (int, error) {
+	num, err := strconv.Atoi(value)
+	if err != nil || num < 1 {
+		return 0, fmt.Errorf("%w: %q", ErrMalformedRecurrence, value)
+	}
+	return num, nil
+}
+
+// parseRRuleList returns the time sets of a list of values (e.g. `1,2,3`).
+func parseRRuleList(value string, min, max int) (sets []timeSet, err error) {
+	for _, v := range strings.Split(value, ",") {
+		num, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrMalformedRecurrence, v)
+		}
+		if num < min || num > max {
+			return nil, ErrValueOutsideRange
+		}
+
+		sets = append(sets, unitExpr(num))
+	}
+	return
+}
+
+// parseRRuleMonthDays returns the time sets of a list of days of the month
+// where negative values count from the end of the month (e.g. `1,-1`).
+func parseRRuleMonthDays(value string) (sets []timeSet, err error) {
+	for _, v := range strings.Split(value, ",") {
+		if strings.HasPrefix(v, "-") {
+			var last []timeSet
+			last, err = parseRRuleList(v[1:], rangeMinDayOfMonth, rangeMaxDayOfMonth)
+			if err != nil {
+				return
+			}
+
+			sets = append(sets, nthLastDayOfMonthExpr{nthLast: int(last[0].(unitExpr)) - 1})
+			continue
+		}
+
+		var day []timeSet
+		day, err = parseRRuleList(strings.TrimPrefix(v, "+"), rangeMinDayOfMonth, rangeMaxDayOfMonth)
+		if err != nil {
+			return
+		}
+		sets = append(sets, day...)
+	}
+	return
+}
+
+// parseRRuleWeekdays returns the time sets of a list of week days, possibly
+// with an ordinal (e.g. `MO,-1FR,2SU`), and true when an ordinal is used.
+func parseRRuleWeekdays(value string) (sets []timeSet, hasOrdinal bool, err error) {
+	const nameLength = 2
+
+	for _, v := range strings.Split(value, ",") {
+		if len(v) < nameLength {
+			return nil, false, fmt.Errorf("%w: %q", ErrMalformedRecurrence, v)
+		}
+
+		weekday := slices.Index(rruleWeekdays, v[len(v)-nameLength:])
+		if weekday < 0 {
+			return nil, false, fmt.Errorf("%w: %q", ErrMalformedRecurrence, v)
+		}
+
+		ordinal := v[:len(v)-nameLength]
+		if ordinal == "" {
+			sets = append(sets, unitExpr(weekday))
+			continue
+		}
+
+		hasOrdinal = true
+
+		nth, err := strconv.Atoi(strings.TrimPrefix(ordinal, "+"))
+		switch {
+		case err != nil || nth == 0:
+			return nil, false, fmt.Errorf("%w: %q", ErrMalformedRecurrence, v)
+		case nth == -1:
+			sets = append(sets, lastWeekDayOfMonthExpr{weekday: time.Weekday(weekday)})
+		default:
+			set := nthWeekdayOfMonthExpr{weekday: time.Weekday(weekday), nth: nth}
+			if !set.SubsetOf(rangeMinWeekday, rangeMaxWeekday) {
+				return nil, false, ErrValueOutsideRange
+			}
+			sets = append(sets, set)
+		}
+	}
+	return
+}
+
+// parseICalTimes returns the list of times of a property (e.g. `RDATE`).
+func parseICalTimes(params map[string]string, value string) ([]time.Time, error) {
+	var times []time.Time
+	for _, v := range strings.Split(value, ",") {
+		t, err := parseICalTime(params, v)
+		if err != nil {
+			return nil, err
+		}
+
+		times = append(times, t.Time)
+	}
+	return times, nil
+}
+
+// datesTimeUnit is a time unit implementation for an explicit list of sorted
+// activation times.
+type datesTimeUnit []time.Time
+
+// Next implements TimeUnit.
+func (u datesTimeUnit) Next(next time.Time) (time.Time, bool) {
+	i := sort.Search(len(u), func(i int) bool { return !u[i].Before(next) })
+	if i == len(u) {
+		return time.Time{}, false
+	}
+
+	candidate := u[i].In(next.Location())
+	return candidate, candidate.Equal(next)
+}
+
+// Previous implements TimeUnit.
+func (u datesTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	i := sort.Search(len(u), func(i int) bool { return u[i].After(before) })
+	if i == 0 {
+		return time.Time{}, false
+	}
+
+	candidate := u[i-1].In(before.Location())
+	return candidate, candidate.Equal(before)
+}
+
+// periodTimeUnit is a time unit implementation that only accepts one period
+// (e.g. a month) every few periods counting from an anchor.
+type periodTimeUnit struct {
+	freq     rruleFreq
+	interval int
+	anchor   time.Time
+	// wkst is the first day of a week when the frequency is weekly.
+	wkst time.Weekday
+}
+
+// Next implements TimeUnit.
+func (u periodTimeUnit) Next(next time.Time) (time.Time, bool) {
+	t := next.In(u.anchor.Location())
+
+	remainder := floorMod(u.index(t), u.interval)
+	if remainder == 0 {
+		return next, true
+	}
+
+	return u.periodStart(t, u.interval-remainder).In(next.Location()), false
+}
+
+// Previous implements TimeUnit.
+func (u periodTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	t := before.In(u.anchor.Location())
+
+	remainder := floorMod(u.index(t), u.interval)
+	if remainder == 0 {
+		return before, true
+	}
+
+	// Move to the end of the last period accepted.
+	return u.periodStart(t, 1-remainder).Add(-time.Second).In(before.Location()), false
+}
+
+// index returns the number of periods between the anchor and the time.
+func (u periodTimeUnit) index(t time.Time) int {
+	const monthsInYear = 12
+
+	a := u.anchor
+
+	switch u.freq {
+	case yearly:
+		return t.Year() - a.Year()
+	case monthly:
+		return (t.Year()-a.Year())*monthsInYear + int(t.Month()-a.Month())
+	case weekly:
+		return floorDiv(civilDays(u.periodStart(t, 0))-civilDays(u.periodStart(a, 0)), daysInWeek)
+	case daily:
+		return civilDays(t) - civilDays(a)
+	default:
+		d := u.periodStart(t, 0).Sub(u.periodStart(a, 0))
+		return int(floorDiv(int64(d), int64(u.freq.duration())))
+	}
+}
+
+// periodStart returns the beginning of the nth period after the one of the
+// time.
+func (u periodTimeUnit) periodStart(t time.Time, n int) time.Time {
+	switch u.freq {
+	case yearly:
+		return time.Date(t.Year()+n, 1, 1, 0, 0, 0, 0, t.Location())
+	case monthly:
+		return time.Date(t.Year(), t.Month()+time.Month(n), 1, 0, 0, 0, 0, t.Location())
+	case weekly:
+		offset := floorMod(int(t.Weekday()-u.wkst), daysInWeek)
+		return time.Date(t.Year(), t.Month(), t.Day()-offset+n*daysInWeek, 0, 0, 0, 0, t.Location())
+	case daily:
+		return time.Date(t.Year(), t.Month(), t.Day()+n, 0, 0, 0, 0, t.Location())
+	case hourly:
+		return setMinutes(t, 0).Add(time.Duration(n) * time.Hour)
+	case minutely:
+		return setSeconds(t, 0).Add(time.Duration(n) * time.Minute)
+	default:
+		return setSeconds(t, t.Second()).Add(time.Duration(n) * time.Second)
+	}
+}
+
+// duration returns the length of a period for the frequencies smaller than a
+// day.
+func (f rruleFreq) duration() time.Duration {
+	switch f {
+	case hourly:
+		return time.Hour
+	case minutely:
+		return time.Minute
+	default:
+		return time.Second
+	}
+}
+
+// civilDays returns the number of days between the Unix epoch and the date of
+// the time, regardless of its location.
+func civilDays(t time.Time) int {
+	const secondsInDay = 24 * 60 * 60
+
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / secondsInDay)
+}
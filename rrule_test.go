@@ -0,0 +1,310 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule_ToRRule(t *testing.T) {
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+	vectors := []struct {
+		expr   string
+		expect string
+	}{
+		{"*/15 * * * * ?", "DTSTART:20230604T000015Z\nRRULE:FREQ=MINUTELY;BYSECOND=0,15,30,45"},
+		{"0 * * * * ?", "DTSTART:20230604T000100Z\nRRULE:FREQ=MINUTELY;BYSECOND=0"},
+		{"0 0 */6 * * ?", "DTSTART:20230604T060000Z\nRRULE:FREQ=DAILY;BYHOUR=0,6,12,18;BYMINUTE=0;BYSECOND=0"},
+		{
+			"0 30 9 ? * MON-FRI",
+			"DTSTART:20230605T093000Z\nRRULE:FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;BYHOUR=9;BYMINUTE=30;BYSECOND=0",
+		},
+		{"0 0 0 L * ?", "DTSTART:20230630T000000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=-1;BYHOUR=0;BYMINUTE=0;BYSECOND=0"},
+		{"0 0 0 1,L-2 * ?", "DTSTART:20230629T000000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=1,-2;BYHOUR=0;BYMINUTE=0;BYSECOND=0"},
+		{
+			"0 0 0 28-L 2 ?",
+			"DTSTART:20240228T000000Z\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=28,29,30,31;BYHOUR=0;BYMINUTE=0;BYSECOND=0",
+		},
+		{"0 0 12 ? * 4#3", "DTSTART:20230615T120000Z\nRRULE:FREQ=MONTHLY;BYDAY=3TH;BYHOUR=12;BYMINUTE=0;BYSECOND=0"},
+		{"0 0 12 ? * 4#-2", "DTSTART:20230622T120000Z\nRRULE:FREQ=MONTHLY;BYDAY=-2TH;BYHOUR=12;BYMINUTE=0;BYSECOND=0"},
+		{"0 0 15 ? 4 0L", "DTSTART:20240428T150000Z\nRRULE:FREQ=YEARLY;BYMONTH=4;BYDAY=-1SU;BYHOUR=15;BYMINUTE=0;BYSECOND=0"},
+		{
+			"0 0 0 1 1 ? *",
+			"DTSTART:20240101T000000Z\nRRULE:FREQ=YEARLY;BYMONTH=1;BYMONTHDAY=1;BYHOUR=0;BYMINUTE=0;BYSECOND=0",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.expr, func(t *testing.T) {
+			rule, err := MustParse(v.expr).ToRRule(from, time.Time{})
+			requireNoError(t, err)
+
+			if rule != v.expect {
+				t.Fatalf("%q != %q", rule, v.expect)
+			}
+		})
+	}
+}
+
+func TestSchedule_ToRRule_addsUntil(t *testing.T) {
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2023, time.June, 30, 0, 0, 0, 0, time.FixedZone("CEST", 2*3600))
+
+	rule, err := MustParse("0 0 0 * * ?").ToRRule(from, until)
+	requireNoError(t, err)
+
+	expect := "DTSTART:20230605T000000Z\nRRULE:FREQ=DAILY;BYHOUR=0;BYMINUTE=0;BYSECOND=0;UNTIL=20230629T220000Z"
+	if rule != expect {
+		t.Fatalf("%q != %q", rule, expect)
+	}
+}
+
+func TestSchedule_ToRRule_listsDatesWhenNotExpressible(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Zurich")
+	requireNoError(t, err)
+
+	from := time.Date(2000, time.March, 15, 0, 0, 0, 0, loc)
+	until := time.Date(2020, time.January, 1, 0, 0, 0, 0, loc)
+
+	rule, err := MustParse("0 0 0 1 6 ? 2010-2012").ToRRule(from, until)
+	requireNoError(t, err)
+
+	expect := "DTSTART;TZID=Europe/Zurich:20100601T000000\n" +
+		"RDATE;TZID=Europe/Zurich:20100601T000000,20110601T000000,20120601T000000"
+	if rule != expect {
+		t.Fatalf("%q != %q", rule, expect)
+	}
+}
+
+func TestSchedule_ToRRule_writesMisnamedZonesInUTC(t *testing.T) {
+	// The zone has the name of a time zone with daylight saving time but a
+	// fixed offset.
+	loc := time.FixedZone("Europe/Zurich", 3600)
+	from := time.Date(2000, time.March, 15, 0, 0, 0, 0, loc)
+	until := time.Date(2020, time.January, 1, 0, 0, 0, 0, loc)
+
+	rule, err := MustParse("0 0 0 1 6 ? 2010-2012").ToRRule(from, until)
+	requireNoError(t, err)
+
+	expect := "DTSTART:20100531T230000Z\nRDATE:20100531T230000Z,20110531T230000Z,20120531T230000Z"
+	if rule != expect {
+		t.Fatalf("%q != %q", rule, expect)
+	}
+
+	// A rule is not written in the zone which only matches in winter, but the
+	// dates are.
+	rule, err = MustParse("0 0 2 * * ?").ToRRule(from, from.AddDate(0, 0, 2))
+	requireNoError(t, err)
+
+	expect = "DTSTART;TZID=Europe/Zurich:20000315T020000\nRDATE;TZID=Europe/Zurich:20000315T020000,20000316T020000"
+	if rule != expect {
+		t.Fatalf("%q != %q", rule, expect)
+	}
+}
+
+func TestSchedule_ToRRule_writesUnnamedZonesInUTC(t *testing.T) {
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.FixedZone("", 3600))
+
+	rule, err := MustParse("0 0 2 * * ?").ToRRule(from, from.AddDate(0, 0, 2))
+	requireNoError(t, err)
+
+	expect := "DTSTART:20230604T010000Z\nRDATE:20230604T010000Z,20230605T010000Z"
+	if rule != expect {
+		t.Fatalf("%q != %q", rule, expect)
+	}
+
+	_, err = MustParse("0 0 2 * * ?").ToRRule(from, time.Time{})
+	requireErrorIs(t, err, ErrNotExpressible)
+}
+
+func TestSchedule_ToRRule_refusesUnboundedDates(t *testing.T) {
+	_, err := MustParse("* * * ? * 5L").ToRRule(time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC), time.Time{})
+	requireErrorIs(t, err, ErrNotExpressible)
+}
+
+func TestSchedule_ToRRule_abortsWithoutActivation(t *testing.T) {
+	_, err := MustParse("0 0 0 1 1 ? 2000").ToRRule(time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC), time.Time{})
+	requireErrorIs(t, err, ErrNoActivation)
+}
+
+func TestParseRRule(t *testing.T) {
+	after := time.Date(2023, time.June, 4, 12, 0, 0, 0, time.UTC)
+	vectors := []struct {
+		expr    string
+		expects []string
+	}{
+		{
+			"FREQ=MONTHLY;BYDAY=-1FR;BYHOUR=0",
+			[]string{"2023-06-30 00:00:00 +0000 UTC", "2023-07-28 00:00:00 +0000 UTC"},
+		},
+		{
+			"FREQ=MONTHLY;BYDAY=-2TH;BYHOUR=0",
+			[]string{"2023-06-22 00:00:00 +0000 UTC", "2023-07-20 00:00:00 +0000 UTC"},
+		},
+		{
+			"FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30",
+			[]string{"2023-06-04 17:30:00 +0000 UTC", "2023-06-05 09:30:00 +0000 UTC"},
+		},
+		{
+			"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=-1",
+			[]string{"2024-02-29 00:00:00 +0000 UTC", "2025-02-28 00:00:00 +0000 UTC"},
+		},
+		{
+			"FREQ=MINUTELY;BYSECOND=0,30;BYDAY=MO",
+			[]string{"2023-06-05 00:00:00 +0000 UTC", "2023-06-05 00:00:30 +0000 UTC"},
+		},
+		{
+			"DTSTART:20230601T083000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			[]string{"2023-06-12 08:30:00 +0000 UTC", "2023-06-16 08:30:00 +0000 UTC", "2023-06-26 08:30:00 +0000 UTC"},
+		},
+		{
+			"DTSTART:20230115T100000Z\nRRULE:FREQ=MONTHLY;INTERVAL=3",
+			[]string{"2023-07-15 10:00:00 +0000 UTC", "2023-10-15 10:00:00 +0000 UTC"},
+		},
+		{
+			"DTSTART:20230604T100000Z\nRRULE:FREQ=HOURLY;INTERVAL=5",
+			[]string{"2023-06-04 15:00:00 +0000 UTC", "2023-06-04 20:00:00 +0000 UTC", "2023-06-05 01:00:00 +0000 UTC"},
+		},
+		{
+			"DTSTART:20230604T100000Z\nRRULE:FREQ=DAILY;COUNT=3",
+			[]string{"2023-06-05 10:00:00 +0000 UTC", "2023-06-06 10:00:00 +0000 UTC", "0001-01-01 00:00:00 +0000 UTC"},
+		},
+		{
+			"DTSTART:20230610T100000Z\nRRULE:FREQ=DAILY;UNTIL=20230611T100000Z",
+			[]string{"2023-06-10 10:00:00 +0000 UTC", "2023-06-11 10:00:00 +0000 UTC", "0001-01-01 00:00:00 +0000 UTC"},
+		},
+		{
+			"DTSTART;TZID=Europe/Zurich:20230101T090000\nRRULE:FREQ=YEARLY",
+			[]string{"2024-01-01 09:00:00 +0100 CET", "2025-01-01 09:00:00 +0100 CET"},
+		},
+		{
+			"DTSTART:20230601T000000Z\nRDATE:20230701T000000Z,20230605T000000Z",
+			[]string{"2023-06-05 00:00:00 +0000 UTC", "2023-07-01 00:00:00 +0000 UTC", "0001-01-01 00:00:00 +0000 UTC"},
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.expr, func(t *testing.T) {
+			schedule, err := ParseRRule(v.expr)
+			requireNoError(t, err)
+
+			testIterator(t, schedule.Upcoming(after), v.expects)
+		})
+	}
+}
+
+func TestParseRRule_iteratesBackwards(t *testing.T) {
+	schedule, err := ParseRRule("DTSTART:20230101T083000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR")
+	requireNoError(t, err)
+
+	expects := []string{
+		"2023-01-27 08:30:00 +0000 UTC",
+		"2023-01-23 08:30:00 +0000 UTC",
+		"2023-01-13 08:30:00 +0000 UTC",
+		"2023-01-09 08:30:00 +0000 UTC",
+		"0001-01-01 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, schedule.Preceding(time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC)), expects)
+}
+
+func TestParseRRule_skipsMissingNegativeMonthDays(t *testing.T) {
+	schedule, err := ParseRRule("FREQ=MONTHLY;BYMONTHDAY=-31,-30;BYHOUR=0;BYMINUTE=0;BYSECOND=0")
+	requireNoError(t, err)
+
+	expects := []string{
+		"2023-04-01 00:00:00 +0000 UTC",
+		"2023-03-02 00:00:00 +0000 UTC",
+		"2023-03-01 00:00:00 +0000 UTC",
+		"2023-01-02 00:00:00 +0000 UTC",
+		"2023-01-01 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, schedule.Preceding(time.Date(2023, time.April, 15, 0, 0, 0, 0, time.UTC)), expects)
+}
+
+func TestParseRRule_roundTrip(t *testing.T) {
+	from := time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)
+	vectors := []string{
+		"*/15 * * * * ?",
+		"0 0 */6 * * ?",
+		"0 30 9 ? * MON-FRI",
+		"0 0 0 1,L-2 * ?",
+		"0 0 12 ? * 4#3",
+		"0 0 15 ? 4 0L",
+		"0 0 0 1 6 ? 2024-2026",
+	}
+
+	for _, v := range vectors {
+		t.Run(v, func(t *testing.T) {
+			schedule := MustParse(v)
+
+			rule, err := schedule.ToRRule(from, time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC))
+			requireNoError(t, err)
+
+			parsed, err := ParseRRule(rule)
+			requireNoError(t, err)
+
+			iter, other := schedule.Upcoming(from), parsed.Upcoming(from)
+			for i := 0; i < 20 && iter.HasNext(); i++ {
+				requireTimeEqual(t, other.Next(), iter.Next().String())
+			}
+		})
+	}
+}
+
+func TestParseRRule_abortsOnMalformedRule(t *testing.T) {
+	vectors := []string{
+		"",
+		"BYHOUR=1",
+		"FREQ=NEVER",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;INTERVAL=2",
+		"FREQ=DAILY;COUNT=2",
+		"FREQ=DAILY;BYHOUR=a",
+		"FREQ=DAILY;BYDAY=2MO",
+		"FREQ=DAILY;BYDAY=XX",
+		"FREQ=DAILY;WKST=XX",
+		"FREQ=MONTHLY",
+		"FREQ",
+		"DTSTART:2023\nRRULE:FREQ=DAILY",
+	}
+
+	for _, v := range vectors {
+		t.Run(v, func(t *testing.T) {
+			_, err := ParseRRule(v)
+			requireErrorIs(t, err, ErrMalformedRecurrence)
+		})
+	}
+}
+
+func TestParseRRule_abortsOnUnsupportedRule(t *testing.T) {
+	vectors := []string{
+		"FREQ=YEARLY;BYWEEKNO=20",
+		"FREQ=YEARLY;BYDAY=1MO",
+		"EXDATE:20230101T000000Z",
+		"DTSTART:20230101T000000Z\nRRULE:FREQ=DAILY\nRDATE:20230101T000000Z",
+	}
+
+	for _, v := range vectors {
+		t.Run(v, func(t *testing.T) {
+			_, err := ParseRRule(v)
+			requireErrorIs(t, err, ErrUnsupportedRecurrence)
+		})
+	}
+}
+
+func TestParseRRule_abortsOnValuesOutsideRange(t *testing.T) {
+	vectors := []string{
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;BYMONTH=0",
+		"FREQ=MONTHLY;BYMONTHDAY=-32",
+		"FREQ=MONTHLY;BYDAY=6MO",
+	}
+
+	for _, v := range vectors {
+		t.Run(v, func(t *testing.T) {
+			_, err := ParseRRule(v)
+			requireErrorIs(t, err, ErrValueOutsideRange)
+		})
+	}
+}
@@ -0,0 +1,436 @@
+package gocron
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Job is the function run at each activation of a schedule. The context is
+// canceled when the scheduler stops without being able to wait for the job.
+type Job func(ctx context.Context) error
+
+// Scheduler runs jobs at the activation times of their schedule. A single
+// timer is armed for the nearest activation of all the jobs.
+type Scheduler struct {
+	clock   Clock
+	onError func(name string, err error)
+
+	mu      sync.Mutex
+	entries entryHeap
+	byName  map[string]*entry
+	started bool
+	stopped bool
+	wake    chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	runs   sync.WaitGroup
+}
+
+var (
+	// errRunReplaced is the cause of the cancellation of a run replaced by a
+	// new one.
+	errRunReplaced = errors.New("run replaced by a new run")
+	// errSchedulerStopped is the cause of the cancellation of the runs which
+	// are still in progress when the scheduler stops.
+	errSchedulerStopped = errors.New("scheduler stopped")
+)
+
+// SchedulerOption is an option to configure a scheduler.
+type SchedulerOption func(*Scheduler)
+
+// JobOption is an option to configure a job added to a scheduler.
+type JobOption func(*entry)
+
+// WithErrorHandler returns an option to set the function called with the
+// error returned by a run of a job.
+func WithErrorHandler(fn func(name string, err error)) SchedulerOption {
+	return func(s *Scheduler) {
+		s.onError = fn
+	}
+}
+
+// WithClock returns an option to set the clock of the scheduler, which is the
+// real clock by default.
+func WithClock(c Clock) SchedulerOption {
+	return func(s *Scheduler) {
+		s.clock = c
+	}
+}
+
+// NewScheduler returns a scheduler configured with the given options. Jobs can
+// be added before and after the scheduler is started.
+func NewScheduler(opts ...SchedulerOption) *Scheduler {
+	s := &Scheduler{
+		clock:  RealClock(),
+		byName: make(map[string]*entry),
+		wake:   make(chan struct{}, 1),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.ctx, s.cancel = context.WithCancelCause(context.Background())
+	return s
+}
+
+// Add registers a job with a unique name which runs at each activation of the
+// schedule from now on, or from the last run when given as an option. It
+// returns an error if the name is already used or if the schedule has no
+// activation.
+func (s *Scheduler) Add(name string, schedule Schedule, job Job, opts ...JobOption) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.byName[name]; found {
+		return ErrJobExists
+	}
+
+	e := &entry{
+		name:             name,
+		schedule:         schedule,
+		job:              job,
+		maxMisfires:      defaultMaxMisfires,
+		misfireThreshold: defaultMisfireThreshold,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	from := s.clock.Now()
+	if !e.lastRun.IsZero() {
+		// Activations between the last run and now are missed and handled by
+		// the misfire policy as soon as the scheduler runs.
+		from = e.lastRun
+	}
+
+	if e.next = schedule.Next(from); e.next.IsZero() {
+		return ErrNoActivation
+	}
+
+	s.byName[name] = e
+	heap.Push(&s.entries, e)
+
+	s.notify()
+	return nil
+}
+
+// Remove unregisters a job and returns true if it exists. Runs in progress are
+// not interrupted.
+func (s *Scheduler) Remove(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.byName[name]
+	if !found {
+		return false
+	}
+
+	s.remove(e)
+	s.notify()
+	return true
+}
+
+// Stats returns the statistics of the runs of a job, and false if the job does
+// not exist.
+func (s *Scheduler) Stats(name string) (JobStats, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.byName[name]
+	if !found {
+		return JobStats{}, false
+	}
+	return e.stats, true
+}
+
+// Start starts running the jobs in the background. It does nothing when the
+// scheduler is already started or stopped.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started || s.stopped {
+		return
+	}
+	s.started = true
+
+	go s.loop()
+}
+
+// Stop stops the scheduler so that no new run starts, and waits for the runs
+// in progress to complete. A run waiting for a retry ends with the error of its
+// last attempt. When the context is done first, the context of the runs is
+// canceled and the error of the context is returned.
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return nil
+	}
+	s.stopped = true
+	started := s.started
+	close(s.stop)
+	s.mu.Unlock()
+
+	if started {
+		<-s.done
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		s.runs.Wait()
+		close(drained)
+	}()
+
+	defer s.cancel(errSchedulerStopped)
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// loop runs the jobs which are due and waits for the nearest activation until
+// the scheduler is stopped.
+func (s *Scheduler) loop() {
+	defer close(s.done)
+
+	for {
+		s.mu.Lock()
+		now := s.clock.Now()
+
+		for len(s.entries) > 0 && !s.entries[0].next.After(now) {
+			e := s.entries[0]
+			s.fire(e, now)
+
+			// Missed activations are handled by the misfire policy and the next
+			// activation is computed from now on.
+			if e.next = e.schedule.Next(now); e.next.IsZero() {
+				s.remove(e)
+			} else {
+				heap.Fix(&s.entries, e.index)
+			}
+		}
+
+		var wait <-chan time.Time
+		var t Timer
+		if len(s.entries) > 0 {
+			t = s.clock.NewTimer(s.entries[0].next.Sub(now))
+			wait = t.C()
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-s.wake:
+		case <-s.stop:
+			if t != nil {
+				t.Stop()
+			}
+			return
+		}
+
+		if t != nil {
+			t.Stop()
+		}
+	}
+}
+
+// fire runs the job for its activations up to now according to the misfire
+// policy of the job.
+func (s *Scheduler) fire(e *entry, now time.Time) {
+	// The latest activation runs normally when it is late by no more than the
+	// threshold, and the earlier ones are either caught up or missed.
+	latest := e.schedule.Previous(now.Add(time.Second))
+	due := !latest.IsZero() && !latest.Before(e.next) && now.Sub(latest) <= e.misfireThreshold
+	missed := now.Sub(e.next) > e.misfireThreshold
+
+	switch {
+	case missed && e.misfire == MisfireFireAll:
+		iter := e.schedule.Upcoming(e.next.Add(-time.Second))
+		for n := 0; n < e.maxMisfires && iter.HasNext(); n++ {
+			if at := iter.Next(); now.Sub(at) <= e.misfireThreshold {
+				break
+			}
+			s.run(e)
+		}
+		if due {
+			s.run(e)
+		}
+	case missed && e.misfire == MisfireFireOnce, due:
+		s.run(e)
+	}
+}
+
+// run starts a run of the job in the background according to the concurrency
+// policy of the job.
+func (s *Scheduler) run(e *entry) {
+	if e.active > 0 {
+		switch e.concurrency {
+		case ConcurrencyForbid:
+			e.stats.Skipped++
+			return
+		case ConcurrencyReplace:
+			e.cancel(errRunReplaced)
+			e.stats.Replaced++
+		}
+	}
+
+	ctx, cancel := s.runContext(e)
+	e.cancel = cancel
+	e.active++
+	e.stats.Runs++
+
+	s.runs.Add(1)
+
+	go func() {
+		defer s.runs.Done()
+		defer cancel(nil)
+
+		err := s.attempt(ctx, e)
+
+		// A run which exceeds its deadline is reported as a timeout even when
+		// the job ignores the context, and a run canceled by a new run or by
+		// the scheduler stopping is not reported.
+		timedOut := errors.Is(ctx.Err(), context.DeadlineExceeded)
+		switch cause := context.Cause(ctx); {
+		case timedOut:
+			err = timeoutError(err)
+		case errors.Is(cause, errRunReplaced), errors.Is(cause, errSchedulerStopped):
+			err = nil
+		}
+
+		s.mu.Lock()
+		e.active--
+		if timedOut {
+			e.stats.TimedOut++
+		}
+		s.mu.Unlock()
+
+		if err != nil && s.onError != nil {
+			s.onError(e.name, err)
+		}
+	}()
+}
+
+// attempt runs the job and retries it according to the retry policy of the job
+// until it succeeds, or no retry can start before the next activation. The
+// error of the last attempt is returned.
+func (s *Scheduler) attempt(ctx context.Context, e *entry) error {
+	next := e.schedule.Next(s.clock.Now())
+
+	err := e.job(ctx)
+	if err == nil || next.IsZero() || !e.retry.retries(err, 1) {
+		return err
+	}
+
+	// The retries are canceled at the next activation so that they never
+	// overlap with its run.
+	ctx, cancel := s.withDeadline(ctx, next)
+	defer cancel()
+
+	for n := 1; e.retry.retries(err, n); n++ {
+		// The next activation takes precedence over a retry which would start
+		// at the same time or later.
+		delay := e.retry.backoff(n)
+		if !s.clock.Now().Add(delay).Before(next) {
+			return err
+		}
+
+		t := s.clock.NewTimer(delay)
+		select {
+		case <-t.C():
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-s.stop:
+			t.Stop()
+			return err
+		}
+
+		s.mu.Lock()
+		e.stats.Retries++
+		s.mu.Unlock()
+
+		err = e.job(ctx)
+	}
+	return err
+}
+
+// remove unregisters the entry of a job.
+func (s *Scheduler) remove(e *entry) {
+	delete(s.byName, e.name)
+	if e.index >= 0 {
+		heap.Remove(&s.entries, e.index)
+	}
+}
+
+// notify wakes up the loop so that it takes changes into account.
+func (s *Scheduler) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// entry is a job registered in the scheduler.
+type entry struct {
+	name             string
+	schedule         Schedule
+	job              Job
+	next             time.Time
+	index            int
+	lastRun          time.Time
+	misfire          MisfirePolicy
+	maxMisfires      int
+	misfireThreshold time.Duration
+	concurrency      ConcurrencyPolicy
+	active           int
+	cancel           context.CancelCauseFunc
+	stats            JobStats
+	retry            RetryPolicy
+	timeout          time.Duration
+	untilNext        bool
+}
+
+// entryHeap is a min-heap of entries ordered by their next activation.
+type entryHeap []*entry
+
+func (h entryHeap) Len() int {
+	return len(h)
+}
+
+func (h entryHeap) Less(i, j int) bool {
+	return h[i].next.Before(h[j].next)
+}
+
+func (h entryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *entryHeap) Push(x any) {
+	e := x.(*entry)
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *entryHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	old[len(old)-1] = nil
+	e.index = -1
+	*h = old[:len(old)-1]
+	return e
+}
@@ -0,0 +1,166 @@
+package gocron
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestScheduler_runsJobsAtActivations(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+	s := NewScheduler(WithClock(clock))
+
+	hourly := make(chan time.Time, 10)
+	requireNoError(t, s.Add("hourly", MustParse("0 0 * * * ?"), func(context.Context) error {
+		hourly <- clock.Now()
+		return nil
+	}))
+
+	daily := make(chan time.Time, 10)
+	requireNoError(t, s.Add("daily", MustParse("0 0 12 * * ?"), func(context.Context) error {
+		daily <- clock.Now()
+		return nil
+	}))
+
+	s.Start()
+	defer func() { requireNoError(t, s.Stop(context.Background())) }()
+
+	clock.BlockUntil(1)
+	clock.Advance(29 * time.Minute)
+	clock.BlockUntil(1)
+	requireNoRun(t, hourly)
+
+	clock.Advance(time.Minute)
+	requireRun(t, hourly, time.Date(2023, time.June, 4, 11, 0, 0, 0, time.UTC))
+	requireNoRun(t, daily)
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Hour)
+	requireRun(t, hourly, time.Date(2023, time.June, 4, 12, 0, 0, 0, time.UTC))
+	requireRun(t, daily, time.Date(2023, time.June, 4, 12, 0, 0, 0, time.UTC))
+}
+
+func TestScheduler_Add_abortsOnDuplicateName(t *testing.T) {
+	s := NewScheduler()
+
+	requireNoError(t, s.Add("job", MustParse("0 0 * * * ?"), nopJob))
+	requireErrorIs(t, s.Add("job", MustParse("0 0 * * * ?"), nopJob), ErrJobExists)
+	requireErrorIs(t, s.Add("past", MustParse("0 0 0 1 1 ? 2000"), nopJob), ErrNoActivation)
+
+	if !s.Remove("job") || s.Remove("job") {
+		t.Fatal("expected the job to be removed once")
+	}
+	requireNoError(t, s.Add("job", MustParse("0 0 * * * ?"), nopJob))
+}
+
+func TestScheduler_reportsErrors(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+	errJob := errors.New("job error")
+
+	errs := make(chan error, 1)
+	s := NewScheduler(WithClock(clock), WithErrorHandler(func(name string, err error) {
+		if name == "failing" {
+			errs <- err
+		}
+	}))
+
+	requireNoError(t, s.Add("failing", MustParse("0 0 * * * ?"), func(context.Context) error {
+		return errJob
+	}))
+
+	s.Start()
+	defer func() { requireNoError(t, s.Stop(context.Background())) }()
+
+	clock.BlockUntil(1)
+	clock.Advance(30 * time.Minute)
+
+	select {
+	case err := <-errs:
+		requireErrorIs(t, err, errJob)
+	case <-time.After(time.Second):
+		t.Fatal("expected an error")
+	}
+}
+
+func TestScheduler_Stop_drainsRuns(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+	s := NewScheduler(WithClock(clock))
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	requireNoError(t, s.Add("slow", MustParse("0 0 * * * ?"), func(context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}))
+
+	s.Start()
+	clock.BlockUntil(1)
+	clock.Advance(30 * time.Minute)
+	<-started
+
+	stopped := make(chan error)
+	go func() { stopped <- s.Stop(context.Background()) }()
+
+	select {
+	case <-stopped:
+		t.Fatal("stop returned before the run completed")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+	requireNoError(t, <-stopped)
+}
+
+func TestScheduler_Stop_cancelsRunsAfterDeadline(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+	s := NewScheduler(WithClock(clock))
+
+	started := make(chan struct{})
+	canceled := make(chan struct{})
+	requireNoError(t, s.Add("slow", MustParse("0 0 * * * ?"), func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+		return ctx.Err()
+	}))
+
+	s.Start()
+	clock.BlockUntil(1)
+	clock.Advance(30 * time.Minute)
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	requireErrorIs(t, s.Stop(ctx), context.Canceled)
+	<-canceled
+}
+
+// --- Utilities
+
+func nopJob(context.Context) error {
+	return nil
+}
+
+func requireRun(t testing.TB, runs <-chan time.Time, expect time.Time) {
+	t.Helper()
+	select {
+	case run := <-runs:
+		if !run.Equal(expect) {
+			t.Fatalf("%v != %v", run, expect)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected a run at %v", expect)
+	}
+}
+
+func requireNoRun(t testing.TB, runs <-chan time.Time) {
+	t.Helper()
+	select {
+	case run := <-runs:
+		t.Fatalf("unexpected run at %v", run)
+	default:
+	}
+}
@@ -0,0 +1,38 @@
+package gocron
+
+import (
+	"time"
+)
+
+// Shift returns a schedule where each activation time happens the duration
+// after the activation of the original schedule, or before it when the
+// duration is negative. The duration is truncated to the second.
+func (s Schedule) Shift(d time.Duration) Schedule {
+	return Schedule{timeUnits: []TimeUnit{shiftTimeUnit{schedule: s, d: d.Truncate(time.Second)}}}
+}
+
+// shiftTimeUnit is a time unit implementation that moves the activation times
+// of a schedule by a duration. The original schedule is searched from the time
+// moved backwards so that the activations close to the given time are found.
+type shiftTimeUnit struct {
+	schedule Schedule
+	d        time.Duration
+}
+
+// Next implements TimeUnit.
+func (u shiftTimeUnit) Next(next time.Time) (time.Time, bool) {
+	candidate := u.schedule.Next(next.Add(-u.d - time.Second))
+	if candidate.IsZero() {
+		return candidate, false
+	}
+	return candidate.Add(u.d), true
+}
+
+// Previous implements TimeUnit.
+func (u shiftTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	candidate := u.schedule.Previous(before.Add(-u.d + time.Second))
+	if candidate.IsZero() {
+		return candidate, false
+	}
+	return candidate.Add(u.d), true
+}
@@ -0,0 +1,56 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule_Shift_returnsActivationsBefore(t *testing.T) {
+	schedule := MustParse("0 0 0 1 * ?").Shift(-15 * time.Minute)
+
+	iter := schedule.Upcoming(time.Date(2023, time.May, 31, 23, 45, 0, 0, time.UTC))
+	expects := []string{
+		"2023-06-30 23:45:00 +0000 UTC",
+		"2023-07-31 23:45:00 +0000 UTC",
+		"2023-08-31 23:45:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Shift_returnsActivationsBackwards(t *testing.T) {
+	schedule := MustParse("0 0 0 1 * ?").Shift(-15 * time.Minute)
+
+	iter := schedule.Preceding(time.Date(2023, time.July, 31, 23, 45, 0, 0, time.UTC))
+	expects := []string{
+		"2023-06-30 23:45:00 +0000 UTC",
+		"2023-05-31 23:45:00 +0000 UTC",
+		"2023-04-30 23:45:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Shift_returnsActivationsAfter(t *testing.T) {
+	schedule := MustParse("0 0 23 L * ?").Shift(2 * time.Hour)
+
+	vectors := []struct {
+		after  time.Time
+		expect time.Time
+	}{
+		{time.Date(2023, time.June, 30, 23, 0, 0, 0, time.UTC), time.Date(2023, time.July, 1, 1, 0, 0, 0, time.UTC)},
+		{time.Date(2023, time.July, 1, 0, 59, 59, 0, time.UTC), time.Date(2023, time.July, 1, 1, 0, 0, 0, time.UTC)},
+		{time.Date(2023, time.July, 1, 1, 0, 0, 0, time.UTC), time.Date(2023, time.August, 1, 1, 0, 0, 0, time.UTC)},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.after.String(), func(t *testing.T) {
+			if next := schedule.Next(v.after); !next.Equal(v.expect) {
+				t.Fatalf("%v != %v", next, v.expect)
+			}
+			if prev := schedule.Previous(v.expect.Add(time.Second)); !prev.Equal(v.expect) {
+				t.Fatalf("%v != %v", prev, v.expect)
+			}
+		})
+	}
+}
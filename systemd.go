@@ -0,0 +1,283 @@
+package gocron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	systemdDateSplitSize     = 3
+	systemdShortDateSize     = 2
+	systemdTimeSplitSize     = 3
+	systemdShortTimeSize     = 2
+	systemdCenturyPivot      = 70
+	systemdMaxTwoDigitsYears = 99
+)
+
+// systemdShorthands are the special expressions of systemd and their
+// normalized form.
+var systemdShorthands = map[string]string{
+	"minutely":     "*-*-* *:*:00",
+	"hourly":       "*-*-* *:00:00",
+	"daily":        "*-*-* 00:00:00",
+	"monthly":      "*-*-01 00:00:00",
+	"weekly":       "Mon *-*-* 00:00:00",
+	"yearly":       "*-01-01 00:00:00",
+	"annually":     "*-01-01 00:00:00",
+	"quarterly":    "*-01,04,07,10-01 00:00:00",
+	"semiannually": "*-01,07-01 00:00:00",
+}
+
+// ParseSystemdCalendar returns a schedule from a systemd calendar event
+// expression as used by the `OnCalendar=` setting of timers (e.g. `Mon..Fri
+// *-*-* 09:00:00`). It returns an error if the syntax is not supported or
+// incorrect.
+//
+// The expression is made of optional week days, an optional date, an optional
+// time and an optional time zone, in that order. A missing date means every
+// day and a missing time means midnight. Fractional seconds are not supported.
+func ParseSystemdCalendar(expression string) (Schedule, error) {
+	return systemdParser{}.Parse(expression)
+}
+
+// systemdParser is a parser for systemd calendar event expressions.
+type systemdParser struct{}
+
+func (p systemdParser) Parse(expression string) (schedule Schedule, err error) {
+	expression = strings.TrimSpace(expression)
+	if normalized, found := systemdShorthands[strings.ToLower(expression)]; found {
+		expression = normalized
+	}
+
+	tokens := strings.Fields(expression)
+	if len(tokens) == 0 {
+		return schedule, ErrMalformedExpression
+	}
+
+	var weekdays, years, months, days, hours, minutes, seconds []timeSet
+	var loc *time.Location
+
+	hours = []timeSet{unitExpr(0)}
+	minutes = []timeSet{unitExpr(0)}
+	seconds = []timeSet{unitExpr(0)}
+
+	if isSystemdWeekdays(tokens[0]) {
+		weekdays, err = p.parseWeekdays(tokens[0])
+		if err != nil {
+			return schedule, newTimeUnitErr(WeekDays, err)
+		}
+		tokens = tokens[1:]
+	}
+	if len(tokens) > 0 && !strings.Contains(tokens[0], ":") && strings.ContainsAny(tokens[0], "-~") {
+		years, months, days, err = p.parseDate(tokens[0])
+		if err != nil {
+			return schedule, err
+		}
+		tokens = tokens[1:]
+	}
+	if len(tokens) > 0 && strings.Contains(tokens[0], ":") {
+		hours, minutes, seconds, err = p.parseTime(tokens[0])
+		if err != nil {
+			return schedule, err
+		}
+		tokens = tokens[1:]
+	}
+	if len(tokens) == 1 {
+		loc, err = time.LoadLocation(tokens[0])
+		if err != nil {
+			return schedule, fmt.Errorf("%w: %v", ErrMalformedExpression, err)
+		}
+		tokens = tokens[1:]
+	}
+	if len(tokens) > 0 {
+		return schedule, ErrMalformedExpression
+	}
+
+	if loc != nil {
+		schedule.timeUnits = append(schedule.timeUnits, locationTimeUnit{loc: loc})
+	}
+
+	schedule.timeUnits = append(schedule.timeUnits,
+		yearTimeUnit(years),
+		monthTimeUnit(months),
+		dayTimeUnit(days),
+		weekdayTimeUnit(weekdays),
+		hourTimeUnit(hours),
+		minTimeUnit(minutes),
+		secTimeUnit(seconds),
+	)
+
+	return schedule, nil
+}
+
+// parseWeekdays returns the time sets of a list of week days which can
+// contain ranges (e.g. `Mon,Wed..Fri`).
+func (p systemdParser) parseWeekdays(expr string) ([]timeSet, error) {
+	sets, err := p.parse(expr, convertSystemdWeekday, rangeMinWeekday, rangeMaxWeekday)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, set := range sets {
+		// Ranges wrapping around the end of the week are not supported.
+		if r, ok := set.(rangeExpr); ok && r.from.(unitExpr) > r.to.(unitExpr) {
+			return nil, ErrMalformedField
+		}
+	}
+	return sets, nil
+}
+
+// parseDate returns the time sets of a date in the form `Y-M-D`, `M-D` or
+// `Y-M~D` where `~` selects days from the end of the month.
+func (p systemdParser) parseDate(expr string) (years, months, days []timeSet, err error) {
+	yearMonth, lastDays, fromEnd := strings.Cut(expr, "~")
+	if fromEnd {
+		// Append a placeholder for the day which is parsed separately.
+		yearMonth += "-*"
+	}
+
+	parts := strings.Split(yearMonth, "-")
+	if len(parts) == systemdShortDateSize {
+		parts = append([]string{"*"}, parts...)
+	}
+	if len(parts) != systemdDateSplitSize {
+		err = ErrMalformedExpression
+		return
+	}
+
+	years, err = p.parse(parts[0], convertSystemdYear, rangeMinYear, rangeMaxYear)
+	if err != nil {
+		err = newTimeUnitErr(Years, err)
+		return
+	}
+	months, err = p.parse(parts[1], convertUnit, rangeMinMonth, rangeMaxMonth)
+	if err != nil {
+		err = newTimeUnitErr(Months, err)
+		return
+	}
+
+	if fromEnd {
+		days, err = p.parseLastDays(lastDays)
+	} else {
+		days, err = p.parse(parts[2], convertUnit, rangeMinDayOfMonth, rangeMaxDayOfMonth)
+	}
+	if err != nil {
+		err = newTimeUnitErr(Days, err)
+	}
+	return
+}
+
+// parseLastDays returns the time sets of the days counted from the end of the
+// month where `1` is the last day, and a repetition moves towards the end of
+// the month (e.g. `7/1` for the last seven days).
+func (p systemdParser) parseLastDays(expr string) (sets []timeSet, err error) {
+	for _, u := range strings.Split(expr, ",") {
+		nth, step, hasStep := strings.Cut(u, "/")
+
+		num, err := strconv.Atoi(nth)
+		if err != nil {
+			return nil, err
+		}
+
+		var set timeSet = nthLastDayOfMonthExpr{nthLast: num - 1}
+		if hasStep {
+			incr, err := strconv.Atoi(step)
+			if err != nil {
+				return nil, err
+			}
+			if incr < 1 {
+				return nil, ErrMalformedField
+			}
+
+			set = intervalExpr{rge: rangeExpr{from: set, to: nthLastDayOfMonthExpr{}}, incr: incr}
+		}
+
+		if !set.SubsetOf(rangeMinDayOfMonth, rangeMaxDayOfMonth) {
+			return nil, ErrValueOutsideRange
+		}
+
+		sets = append(sets, set)
+	}
+	return
+}
+
+// parseTime returns the time sets of a time in the form `H:M:S` or `H:M`.
+func (p systemdParser) parseTime(expr string) (hours, minutes, seconds []timeSet, err error) {
+	parts := strings.Split(expr, ":")
+	if len(parts) == systemdShortTimeSize {
+		parts = append(parts, "00")
+	}
+	if len(parts) != systemdTimeSplitSize {
+		err = ErrMalformedExpression
+		return
+	}
+
+	hours, err = p.parse(parts[0], convertUnit, rangeMinHour, rangeMaxHour)
+	if err != nil {
+		err = newTimeUnitErr(Hours, err)
+		return
+	}
+	minutes, err = p.parse(parts[1], convertUnit, rangeMinMinute, rangeMaxMinute)
+	if err != nil {
+		err = newTimeUnitErr(Minutes, err)
+		return
+	}
+	seconds, err = p.parse(parts[2], convertUnit, rangeMinSecond, rangeMaxSecond)
+	if err != nil {
+		err = newTimeUnitErr(Seconds, err)
+	}
+	return
+}
+
+// parse returns the time sets of a component where ranges use `..` as the
+// separator, which is then handled like a Cron field.
+func (systemdParser) parse(expr string, convFn converterFn, min, max int) ([]timeSet, error) {
+	if expr == "?" || strings.Contains(expr, "-") {
+		// Those are not part of the syntax and would be misinterpreted.
+		return nil, ErrMalformedField
+	}
+
+	return defaultParser.parse(strings.ReplaceAll(expr, "..", "-"), convFn, min, max)
+}
+
+// isSystemdWeekdays returns true if the token is a list of week days.
+func isSystemdWeekdays(token string) bool {
+	r := token[0]
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+// convertSystemdWeekday returns the week day of an abbreviated or full English
+// name.
+func convertSystemdWeekday(value string) (timeSet, error) {
+	value = strings.ToLower(value)
+	if weekday, found := weekdays[value]; found {
+		return unitExpr(weekday), nil
+	}
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if value == strings.ToLower(weekday.String()) {
+			return unitExpr(weekday), nil
+		}
+	}
+	return nil, ErrMalformedField
+}
+
+// convertSystemdYear returns the year where two digits years are in the range
+// 1970 to 2069.
+func convertSystemdYear(value string) (timeSet, error) {
+	num, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(value) <= 2 && num <= systemdMaxTwoDigitsYears {
+		if num < systemdCenturyPivot {
+			num += 2000
+		} else {
+			num += 1900
+		}
+	}
+	return unitExpr(num), nil
+}
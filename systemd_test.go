@@ -0,0 +1,148 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSystemdCalendar(t *testing.T) {
+	after := time.Date(2023, time.June, 4, 12, 0, 0, 0, time.UTC)
+	vectors := []struct {
+		expr    string
+		expects []string
+	}{
+		{
+			"Mon..Fri *-*-* 09:00:00",
+			[]string{"2023-06-05 09:00:00 +0000 UTC", "2023-06-06 09:00:00 +0000 UTC"},
+		},
+		{
+			"*-*-01/2 00:00",
+			[]string{"2023-06-05 00:00:00 +0000 UTC", "2023-06-07 00:00:00 +0000 UTC"},
+		},
+		{
+			"weekly",
+			[]string{"2023-06-05 00:00:00 +0000 UTC", "2023-06-12 00:00:00 +0000 UTC"},
+		},
+		{
+			"quarterly",
+			[]string{"2023-07-01 00:00:00 +0000 UTC", "2023-10-01 00:00:00 +0000 UTC"},
+		},
+		{
+			"Sat,Sun 10:30",
+			[]string{"2023-06-10 10:30:00 +0000 UTC", "2023-06-11 10:30:00 +0000 UTC"},
+		},
+		{
+			"*:0/15",
+			[]string{"2023-06-04 12:15:00 +0000 UTC", "2023-06-04 12:30:00 +0000 UTC"},
+		},
+		{
+			"*-02~03",
+			[]string{"2024-02-27 00:00:00 +0000 UTC", "2025-02-26 00:00:00 +0000 UTC"},
+		},
+		{
+			"Mon *-05~07/1",
+			[]string{"2024-05-27 00:00:00 +0000 UTC", "2025-05-26 00:00:00 +0000 UTC"},
+		},
+		{
+			"2024..2025-12-25 08:00:00",
+			[]string{"2024-12-25 08:00:00 +0000 UTC", "2025-12-25 08:00:00 +0000 UTC", "0001-01-01 00:00:00 +0000 UTC"},
+		},
+		{
+			"24-01-01",
+			[]string{"2024-01-01 00:00:00 +0000 UTC", "0001-01-01 00:00:00 +0000 UTC"},
+		},
+		{
+			"06-05 12:00:01,02",
+			[]string{"2023-06-05 12:00:01 +0000 UTC", "2023-06-05 12:00:02 +0000 UTC", "2024-06-05 12:00:01 +0000 UTC"},
+		},
+		{
+			"Wednesday 09..10:00 Europe/Zurich",
+			[]string{"2023-06-07 09:00:00 +0200 CEST", "2023-06-07 10:00:00 +0200 CEST"},
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.expr, func(t *testing.T) {
+			schedule, err := ParseSystemdCalendar(v.expr)
+			requireNoError(t, err)
+
+			testIterator(t, schedule.Upcoming(after), v.expects)
+		})
+	}
+}
+
+func TestParseSystemdCalendar_iteratesBackwards(t *testing.T) {
+	schedule, err := ParseSystemdCalendar("Mon *-05~07/1 12:00")
+	requireNoError(t, err)
+
+	expects := []string{
+		"2023-05-29 12:00:00 +0000 UTC",
+		"2022-05-30 12:00:00 +0000 UTC",
+		"2021-05-31 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, schedule.Preceding(time.Date(2023, time.June, 4, 0, 0, 0, 0, time.UTC)), expects)
+}
+
+func TestParseSystemdCalendar_abortsOnMalformedExpression(t *testing.T) {
+	vectors := []string{
+		"",
+		"*-*-* 00:00:00 Mars/Olympus",
+		"*-*-*-* 00:00",
+		"*-*-* 00",
+		"00:00 00:00",
+	}
+
+	for _, v := range vectors {
+		t.Run(v, func(t *testing.T) {
+			_, err := ParseSystemdCalendar(v)
+			requireErrorIs(t, err, ErrMalformedExpression)
+		})
+	}
+}
+
+func TestParseSystemdCalendar_abortsOnMalformedField(t *testing.T) {
+	vectors := []struct {
+		expr string
+		kind TimeUnitKind
+	}{
+		{"Funday", WeekDays},
+		{"Fri..Mon", WeekDays},
+		{"1x-*-*", Years},
+		{"*-a-*", Months},
+		{"*-*-a", Days},
+		{"*-*~a", Days},
+		{"*-*~1/0", Days},
+		{"1x:00", Hours},
+		{"*:a", Minutes},
+		{"*:*:1-2", Seconds},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.expr, func(t *testing.T) {
+			_, err := ParseSystemdCalendar(v.expr)
+
+			var e TimeUnitError
+			requireErrorAs(t, err, &e)
+			requireSameKind(t, e.Kind(), v.kind)
+		})
+	}
+}
+
+func TestParseSystemdCalendar_abortsOnValuesOutsideRange(t *testing.T) {
+	vectors := []string{
+		"*-13-*",
+		"*-*-32",
+		"*-*~32",
+		"24:00",
+		"*:60",
+		"*:*:60",
+	}
+
+	for _, v := range vectors {
+		t.Run(v, func(t *testing.T) {
+			_, err := ParseSystemdCalendar(v)
+			requireErrorIs(t, err, ErrValueOutsideRange)
+		})
+	}
+}
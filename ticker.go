@@ -0,0 +1,125 @@
+package gocron
+
+import (
+	"sync"
+	"time"
+)
+
+// tickerMaxWait is the longest duration a ticker waits before computing the
+// next activation time again, so that a change of the wall clock delays a tick
+// by this duration at most.
+const tickerMaxWait = time.Minute
+
+// Ticker delivers the activation times of a schedule on a channel, like
+// time.Ticker does for a period.
+type Ticker struct {
+	// C is the channel on which the activation times are delivered. Ticks are
+	// dropped when the previous one has not been received yet.
+	C <-chan time.Time
+
+	c     chan time.Time
+	clock Clock
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+}
+
+// TickerOption is an option to configure a ticker.
+type TickerOption func(*Ticker)
+
+// WithTickerClock returns an option to set the clock of the ticker, which is
+// the real clock by default.
+func WithTickerClock(c Clock) TickerOption {
+	return func(t *Ticker) {
+		t.clock = c
+	}
+}
+
+// NewTicker returns a ticker which delivers each activation time of the
+// schedule from now on. The ticker stops by itself when the schedule has no
+// more activation.
+//
+// The next activation time is computed from the current time at least every
+// minute. When the wall clock jumps forwards, the missed activations produce a
+// single tick, and when it jumps backwards, the activations are delivered
+// again.
+func NewTicker(schedule Schedule, opts ...TickerOption) *Ticker {
+	c := make(chan time.Time, 1)
+
+	t := &Ticker{C: c, c: c, clock: RealClock()}
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	t.start(schedule)
+	return t
+}
+
+// Stop turns off the ticker so that no more ticks are delivered. It does not
+// close the channel.
+func (t *Ticker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.halt()
+}
+
+// Reset stops the ticker and starts it again with the schedule. A tick of the
+// previous schedule which has not been received yet stays in the channel.
+func (t *Ticker) Reset(schedule Schedule) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.halt()
+	t.start(schedule)
+}
+
+// start runs the ticker in the background for the schedule.
+func (t *Ticker) start(schedule Schedule) {
+	t.stop = make(chan struct{})
+	t.done = make(chan struct{})
+
+	go t.run(schedule, t.stop, t.done)
+}
+
+// halt stops the ticker running in the background if any and waits for it.
+func (t *Ticker) halt() {
+	if t.stop == nil {
+		return
+	}
+	close(t.stop)
+	<-t.done
+	t.stop = nil
+}
+
+// run delivers the activation times of the schedule until it is stopped.
+func (t *Ticker) run(schedule Schedule, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	now := t.clock.Now()
+	next := schedule.Next(now)
+
+	for !next.IsZero() {
+		timer := t.clock.NewTimer(min(next.Sub(now), tickerMaxWait))
+
+		select {
+		case <-timer.C():
+		case <-stop:
+			timer.Stop()
+			return
+		}
+
+		now = t.clock.Now()
+		if !now.Before(next) {
+			select {
+			case t.c <- next:
+			default:
+			}
+		}
+
+		// The activation is computed again in case the wall clock has changed
+		// while waiting.
+		next = schedule.Next(now)
+	}
+}
@@ -0,0 +1,119 @@
+package gocron
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTicker_deliversActivations(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 58, 0, 0, time.UTC))
+
+	ticker := NewTicker(MustParse("0 * * * * ?"), WithTickerClock(clock))
+	defer ticker.Stop()
+
+	advanceTicker(clock, 1)
+	requireRun(t, ticker.C, time.Date(2023, time.June, 4, 10, 59, 0, 0, time.UTC))
+
+	advanceTicker(clock, 1)
+	requireRun(t, ticker.C, time.Date(2023, time.June, 4, 11, 0, 0, 0, time.UTC))
+	requireNoRun(t, ticker.C)
+}
+
+func TestTicker_dropsTicksOfSlowConsumers(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 58, 0, 0, time.UTC))
+
+	ticker := NewTicker(MustParse("0 * * * * ?"), WithTickerClock(clock))
+	defer ticker.Stop()
+
+	advanceTicker(clock, 3)
+	clock.BlockUntil(1)
+	requireRun(t, ticker.C, time.Date(2023, time.June, 4, 10, 59, 0, 0, time.UTC))
+	requireNoRun(t, ticker.C)
+
+	advanceTicker(clock, 1)
+	requireRun(t, ticker.C, time.Date(2023, time.June, 4, 11, 2, 0, 0, time.UTC))
+}
+
+func TestTicker_followsWallClock(t *testing.T) {
+	clock := &jumpClock{FakeClock: NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))}
+
+	ticker := NewTicker(MustParse("0 0 * * * ?"), WithTickerClock(clock))
+	defer ticker.Stop()
+
+	// The missed activations produce a single tick when the clock jumps
+	// forwards.
+	advanceTicker(clock.FakeClock, 1)
+	clock.jump(5 * time.Hour)
+	advanceTicker(clock.FakeClock, 1)
+	requireRun(t, ticker.C, time.Date(2023, time.June, 4, 11, 0, 0, 0, time.UTC))
+	requireNoRun(t, ticker.C)
+
+	// The activations are delivered again when the clock jumps backwards.
+	clock.jump(-2 * time.Hour)
+	advanceTicker(clock.FakeClock, 28)
+	requireRun(t, ticker.C, time.Date(2023, time.June, 4, 14, 0, 0, 0, time.UTC))
+}
+
+func TestTicker_Reset(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 58, 0, 0, time.UTC))
+
+	ticker := NewTicker(MustParse("0 0 * * * ?"), WithTickerClock(clock))
+	defer ticker.Stop()
+
+	advanceTicker(clock, 1)
+	ticker.Reset(MustParse("0 * * * * ?"))
+
+	advanceTicker(clock, 1)
+	requireRun(t, ticker.C, time.Date(2023, time.June, 4, 11, 0, 0, 0, time.UTC))
+
+	ticker.Stop()
+	ticker.Stop()
+
+	clock.Advance(59 * time.Minute)
+	requireNoRun(t, ticker.C)
+
+	ticker.Reset(MustParse("0 0 * * * ?"))
+
+	advanceTicker(clock, 1)
+	requireRun(t, ticker.C, time.Date(2023, time.June, 4, 12, 0, 0, 0, time.UTC))
+}
+
+func TestTicker_stopsWithoutActivation(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 58, 0, 0, time.UTC))
+
+	ticker := NewTicker(MustParse("0 0 0 1 1 ? 2023"), WithTickerClock(clock))
+	ticker.Stop()
+
+	requireNoRun(t, ticker.C)
+}
+
+// advanceTicker moves the clock by one minute, which is the longest wait of a
+// ticker, n times after the ticker armed its timer.
+func advanceTicker(clock *FakeClock, n int) {
+	for i := 0; i < n; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+	}
+}
+
+// jumpClock is a fake clock where the wall clock can jump without firing the
+// timers.
+type jumpClock struct {
+	*FakeClock
+
+	mu     sync.Mutex
+	offset time.Duration
+}
+
+func (c *jumpClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.FakeClock.Now().Add(c.offset)
+}
+
+func (c *jumpClock) jump(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.offset += d
+}
@@ -85,6 +85,8 @@ func (u dayTimeUnit) Next(next time.Time) (time.Time, bool) {
 		return next, true
 	}
 
+	var candidates []int
+
 	for _, set := range u {
 		day, direction := set.NearestCandidate(next, next.Day(), true)
 
@@ -92,18 +94,25 @@ func (u dayTimeUnit) Next(next time.Time) (time.Time, bool) {
 		case hit:
 			return next, true
 		case inRange:
-			if next = setDays(next, day); next.Day() == day {
-				// Day fits inside the current month.
-				return next, true
-			}
-
-			// When the day is higher than what the current month supports (e.g.
-			// 30 for February).
-			return setMonths(next, next.Month()), false
+			candidates = append(candidates, day)
 		}
 	}
 
-	return setMonths(next, next.Month()+1), false
+	if len(candidates) == 0 {
+		return setMonths(next, next.Month()+1), false
+	}
+
+	// The smallest candidate is the nearest as the sets are not ordered (e.g.
+	// `L,20`).
+	day := slices.Min(candidates)
+	if next = setDays(next, day); next.Day() == day {
+		// Day fits inside the current month.
+		return next, true
+	}
+
+	// When the day is higher than what the current month supports (e.g. 30 for
+	// February).
+	return setMonths(next, next.Month()), false
 }
 
 func (u dayTimeUnit) Previous(before time.Time) (time.Time, bool) {
@@ -168,7 +177,8 @@ func (u weekdayTimeUnit) Previous(before time.Time) (time.Time, bool) {
 		}
 	}
 
-	return setDays(before, before.Day()-1), false
+	// Move to the end of the previous day.
+	return setDays(before, before.Day()).Add(-time.Second), false
 }
 
 type yearTimeUnit []timeSet
@@ -276,3 +286,52 @@ func setMonths(t time.Time, months time.Month) time.Time {
 func setYears(t time.Time, years int) time.Time {
 	return time.Date(years, 1, 1, 0, 0, 0, 0, t.Location())
 }
+
+// windowTimeUnit is a time unit implementation that restricts the activation
+// times to an inclusive window. A zero bound leaves the window open on that
+// side.
+type windowTimeUnit struct {
+	start time.Time
+	end   time.Time
+	// count is the number of activations within the window when it is bounded
+	// by a number of activations, otherwise it is zero.
+	count int
+}
+
+// Next implements TimeUnit.
+func (u windowTimeUnit) Next(next time.Time) (time.Time, bool) {
+	if !u.end.IsZero() && next.After(u.end) {
+		return time.Time{}, false
+	}
+	if !u.start.IsZero() && next.Before(u.start) {
+		return u.start.In(next.Location()), false
+	}
+	return next, true
+}
+
+// Previous implements TimeUnit.
+func (u windowTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	if !u.start.IsZero() && before.Before(u.start) {
+		return time.Time{}, false
+	}
+	if !u.end.IsZero() && before.After(u.end) {
+		return u.end.In(before.Location()), false
+	}
+	return before, true
+}
+
+// locationTimeUnit is a time unit implementation that moves the time to a
+// location so that the following time units are evaluated in it.
+type locationTimeUnit struct {
+	loc *time.Location
+}
+
+// Next implements TimeUnit.
+func (u locationTimeUnit) Next(next time.Time) (time.Time, bool) {
+	return next.In(u.loc), true
+}
+
+// Previous implements TimeUnit.
+func (u locationTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	return before.In(u.loc), true
+}
@@ -0,0 +1,124 @@
+package gocron
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// WithRunTimeout returns an option to set the maximum duration of a run of a
+// job, including its retries. The context of the run has a deadline, and a run
+// which exceeds it is reported with ErrRunTimeout.
+func WithRunTimeout(d time.Duration) JobOption {
+	return func(e *entry) {
+		e.timeout = d
+	}
+}
+
+// WithDeadlineAtNextActivation returns an option so that a run of a job must
+// complete before the next activation of the schedule. It can be combined with
+// WithRunTimeout, in which case the earliest deadline applies.
+func WithDeadlineAtNextActivation() JobOption {
+	return func(e *entry) {
+		e.untilNext = true
+	}
+}
+
+// deadline returns the deadline of a run starting at the given time, or a zero
+// time if the run has none.
+func (e *entry) deadline(now time.Time) (deadline time.Time) {
+	if e.timeout > 0 {
+		deadline = now.Add(e.timeout)
+	}
+	if e.untilNext {
+		if next := e.schedule.Next(now); !next.IsZero() && (deadline.IsZero() || next.Before(deadline)) {
+			deadline = next
+		}
+	}
+	return
+}
+
+// runContext returns the context of a run with the deadline of the job if any.
+// It is canceled with a cause when the run is interrupted.
+func (s *Scheduler) runContext(e *entry) (context.Context, context.CancelCauseFunc) {
+	ctx, cancel := context.WithCancelCause(s.ctx)
+
+	deadline := e.deadline(s.clock.Now())
+	if deadline.IsZero() {
+		return ctx, cancel
+	}
+
+	ctx, stop := s.withDeadline(ctx, deadline)
+	return ctx, func(cause error) {
+		cancel(cause)
+		stop()
+	}
+}
+
+// withDeadline returns a context which is done at the deadline according to the
+// clock of the scheduler, or when the parent is done.
+func (s *Scheduler) withDeadline(parent context.Context, deadline time.Time) (context.Context, context.CancelFunc) {
+	c := &clockDeadlineContext{Context: parent, deadline: deadline, done: make(chan struct{})}
+	if d, ok := parent.Deadline(); ok && d.Before(deadline) {
+		c.deadline = d
+	}
+
+	t := s.clock.AfterFunc(deadline.Sub(s.clock.Now()), func() {
+		c.cancel(context.DeadlineExceeded)
+	})
+	stop := context.AfterFunc(parent, func() {
+		c.cancel(parent.Err())
+	})
+
+	return c, func() {
+		t.Stop()
+		stop()
+		c.cancel(context.Canceled)
+	}
+}
+
+// clockDeadlineContext is a context canceled by a timer of a clock, so that a
+// fake clock drives the deadline like the real one. The values and the cause
+// of the cancellation are the ones of the parent.
+type clockDeadlineContext struct {
+	context.Context
+	deadline time.Time
+	done     chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+func (c *clockDeadlineContext) Deadline() (time.Time, bool) {
+	return c.deadline, true
+}
+
+func (c *clockDeadlineContext) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *clockDeadlineContext) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// cancel closes the context with the error unless it is already closed.
+func (c *clockDeadlineContext) cancel(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err == nil {
+		c.err = err
+		close(c.done)
+	}
+}
+
+// timeoutError returns the error of a run which exceeded its deadline.
+func timeoutError(err error) error {
+	if err == nil {
+		return ErrRunTimeout
+	}
+	return fmt.Errorf("%w: %w", ErrRunTimeout, err)
+}
@@ -0,0 +1,177 @@
+package gocron
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEntry_deadline(t *testing.T) {
+	now := time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC)
+
+	vectors := []struct {
+		expr   string
+		opts   []JobOption
+		expect time.Time
+	}{
+		{expr: "0 0 * * * ?"},
+		{expr: "0 0 * * * ?", opts: []JobOption{WithRunTimeout(time.Minute)}, expect: now.Add(time.Minute)},
+		{expr: "0 0 * * * ?", opts: []JobOption{WithDeadlineAtNextActivation()}, expect: now.Add(30 * time.Minute)},
+		{
+			expr:   "0 0 * * * ?",
+			opts:   []JobOption{WithRunTimeout(time.Hour), WithDeadlineAtNextActivation()},
+			expect: now.Add(30 * time.Minute),
+		},
+		{
+			expr:   "0 0 * * * ?",
+			opts:   []JobOption{WithRunTimeout(time.Minute), WithDeadlineAtNextActivation()},
+			expect: now.Add(time.Minute),
+		},
+		{expr: "0 0 0 1 1 ? 2023", opts: []JobOption{WithDeadlineAtNextActivation()}},
+	}
+
+	for _, v := range vectors {
+		e := &entry{schedule: MustParse(v.expr)}
+		for _, opt := range v.opts {
+			opt(e)
+		}
+
+		if deadline := e.deadline(now); !deadline.Equal(v.expect) {
+			t.Fatalf("%s: %v != %v", v.expr, deadline, v.expect)
+		}
+	}
+}
+
+func TestScheduler_reportsTimeouts(t *testing.T) {
+	errJob := errors.New("job error")
+
+	vectors := []struct {
+		name     string
+		opts     []JobOption
+		deadline time.Time
+		jobErr   error
+	}{
+		{
+			name:     "run timeout",
+			opts:     []JobOption{WithRunTimeout(10 * time.Second)},
+			deadline: time.Date(2023, time.June, 4, 10, 31, 10, 0, time.UTC),
+			jobErr:   errJob,
+		},
+		{
+			name:     "next activation",
+			opts:     []JobOption{WithDeadlineAtNextActivation()},
+			deadline: time.Date(2023, time.June, 4, 10, 32, 0, 0, time.UTC),
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.name, func(t *testing.T) {
+			clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+
+			errs := make(chan error, 1)
+			s := NewScheduler(WithClock(clock), WithErrorHandler(func(name string, err error) {
+				errs <- err
+			}))
+
+			// The run of the next activation might start and is released when
+			// the test ends.
+			done := make(chan struct{})
+			deadlines := make(chan time.Time, 2)
+			requireNoError(t, s.Add("job", MustParse("0 * * * * ?"), func(ctx context.Context) error {
+				deadline, _ := ctx.Deadline()
+				deadlines <- deadline
+				select {
+				case <-ctx.Done():
+					return v.jobErr
+				case <-done:
+					return nil
+				}
+			}, append(v.opts, WithConcurrencyPolicy(ConcurrencyForbid))...))
+
+			s.Start()
+			defer func() {
+				close(done)
+				requireNoError(t, s.Stop(context.Background()))
+			}()
+
+			clock.BlockUntil(1)
+			clock.Advance(time.Minute)
+			requireRun(t, deadlines, v.deadline)
+
+			// The loop and the deadline of the run are waiting.
+			clock.BlockUntil(2)
+			clock.Advance(v.deadline.Sub(clock.Now()))
+
+			select {
+			case err := <-errs:
+				requireErrorIs(t, err, ErrRunTimeout)
+				if v.jobErr != nil {
+					requireErrorIs(t, err, v.jobErr)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("expected a timeout")
+			}
+
+			if stats, _ := s.Stats("job"); stats.TimedOut != 1 {
+				t.Fatalf("unexpected statistics: %+v", stats)
+			}
+		})
+	}
+}
+
+func TestScheduler_runWithinDeadline(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+	s := NewScheduler(WithClock(clock))
+
+	requireNoError(t, s.Add("job", MustParse("0 * * * * ?"), func(ctx context.Context) error {
+		return errors.New("job error")
+	}, WithRunTimeout(10*time.Second)))
+
+	s.Start()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	clock.BlockUntil(1)
+	requireNoError(t, s.Stop(context.Background()))
+
+	if stats, _ := s.Stats("job"); stats != (JobStats{Runs: 1}) {
+		t.Fatalf("unexpected statistics: %+v", stats)
+	}
+}
+
+func TestScheduler_runContext(t *testing.T) {
+	clock := NewFakeClock(time.Date(2023, time.June, 4, 10, 30, 0, 0, time.UTC))
+	s := NewScheduler(WithClock(clock))
+
+	ctx, cancel := s.runContext(&entry{schedule: MustParse("0 * * * * ?"), timeout: time.Second})
+	defer cancel(nil)
+
+	child, cancelChild := context.WithCancel(ctx)
+	defer cancelChild()
+
+	if deadline, ok := ctx.Deadline(); !ok || !deadline.Equal(clock.Now().Add(time.Second)) {
+		t.Fatalf("unexpected deadline: %v", deadline)
+	}
+	requireNoError(t, ctx.Err())
+
+	clock.Advance(time.Second)
+	<-ctx.Done()
+	requireErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+	<-child.Done()
+	requireErrorIs(t, child.Err(), context.DeadlineExceeded)
+
+	ctx, cancel = s.runContext(&entry{schedule: MustParse("0 * * * * ?"), timeout: time.Second})
+	cancel(nil)
+	requireErrorIs(t, ctx.Err(), context.Canceled)
+}
+
+func TestScheduler_runContext_realClock(t *testing.T) {
+	s := NewScheduler()
+
+	ctx, cancel := s.runContext(&entry{schedule: MustParse("0 * * * * ?"), timeout: time.Millisecond})
+	defer cancel(nil)
+
+	<-ctx.Done()
+	requireErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+}
@@ -0,0 +1,88 @@
+package gocron
+
+import (
+	"fmt"
+	"time"
+)
+
+// VerifyTimeUnit checks that a time unit follows the contract of the TimeUnit
+// interface between the two times, and returns an error wrapping
+// ErrInconsistentTimeUnit otherwise. It is meant to be used in the tests of
+// custom implementations.
+//
+// Every accepted second is visited, so the range should be kept short (e.g. a
+// few days) when the unit accepts most of the times.
+func VerifyTimeUnit(u TimeUnit, from, until time.Time) error {
+	from = from.Truncate(time.Second)
+	until = until.Truncate(time.Second)
+
+	forwards, err := verifyDirection(u, from, until, true)
+	if err != nil {
+		return err
+	}
+	backwards, err := verifyDirection(u, from, until, false)
+	if err != nil {
+		return err
+	}
+
+	if len(forwards) != len(backwards) {
+		return fmt.Errorf("%w: %d accepted times forwards but %d backwards",
+			ErrInconsistentTimeUnit, len(forwards), len(backwards))
+	}
+	for i, t := range forwards {
+		if other := backwards[len(backwards)-1-i]; !t.Equal(other) {
+			return fmt.Errorf("%w: %v accepted forwards but %v backwards", ErrInconsistentTimeUnit, t, other)
+		}
+	}
+
+	return nil
+}
+
+// verifyDirection returns the times accepted by the unit when going forwards
+// from the first time, or backwards from the last one.
+func verifyDirection(u TimeUnit, from, until time.Time, forwards bool) (accepted []time.Time, err error) {
+	move, name, step, t := u.Next, "next", time.Second, from
+	if !forwards {
+		move, name, step, t = u.Previous, "previous", -time.Second, until
+	}
+
+	accepts := func(t time.Time) bool {
+		other, ok := move(t)
+		return ok && other.Equal(t)
+	}
+	// ahead returns true if a is strictly after b in the direction.
+	ahead := func(a, b time.Time) bool {
+		if forwards {
+			return a.After(b)
+		}
+		return a.Before(b)
+	}
+
+	for !t.Before(from) && !t.After(until) {
+		other, ok := move(t)
+
+		switch {
+		case ok && other.Equal(t):
+			accepted = append(accepted, t)
+			t = t.Add(step)
+			continue
+		case !ok && other.IsZero():
+			return accepted, nil
+		case !ahead(other, t):
+			return nil, fmt.Errorf("%w: %s of %v moves to %v", ErrInconsistentTimeUnit, name, t, other)
+		case !other.Equal(other.Truncate(time.Second)):
+			return nil, fmt.Errorf("%w: %s of %v is not truncated: %v", ErrInconsistentTimeUnit, name, t, other)
+		case ok && !accepts(other):
+			return nil, fmt.Errorf("%w: %s of %v returns %v which is not accepted", ErrInconsistentTimeUnit, name, t, other)
+		}
+
+		// The time before the jump must be rejected too, otherwise the jump
+		// skipped an accepted time.
+		if skipped := other.Add(-step); ahead(skipped, t) && accepts(skipped) {
+			return nil, fmt.Errorf("%w: %s of %v skips %v", ErrInconsistentTimeUnit, name, t, skipped)
+		}
+
+		t = other
+	}
+	return
+}
@@ -0,0 +1,86 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+// tradingDaysUnit is a custom time unit which accepts the days from Monday to
+// Friday.
+type tradingDaysUnit struct{}
+
+func (tradingDaysUnit) Next(next time.Time) (time.Time, bool) {
+	if (weekdayCalendar{}).IsBusinessDay(next) {
+		return next, true
+	}
+	return setDays(next, next.Day()+1), false
+}
+
+func (tradingDaysUnit) Previous(before time.Time) (time.Time, bool) {
+	if (weekdayCalendar{}).IsBusinessDay(before) {
+		return before, true
+	}
+	return setDays(before, before.Day()).Add(-time.Second), false
+}
+
+// forwardsOnlyUnit is a broken time unit which accepts every time forwards
+// but none backwards.
+type forwardsOnlyUnit struct{}
+
+func (forwardsOnlyUnit) Next(next time.Time) (time.Time, bool) {
+	return next, true
+}
+
+func (forwardsOnlyUnit) Previous(before time.Time) (time.Time, bool) {
+	return before.Add(-time.Hour), false
+}
+
+// skippingUnit is a broken time unit which skips the accepted times at the
+// beginning of every hour.
+type skippingUnit struct{}
+
+func (skippingUnit) Next(next time.Time) (time.Time, bool) {
+	if next.Minute() == 0 {
+		return next, true
+	}
+	return setHours(next, next.Hour()+1).Add(time.Second), false
+}
+
+func (skippingUnit) Previous(before time.Time) (time.Time, bool) {
+	if before.Minute() == 0 {
+		return before, true
+	}
+	return setMinutes(before, 0).Add(-time.Second), false
+}
+
+func TestNewSchedule_combinesCustomTimeUnits(t *testing.T) {
+	units := MustParse("0 0 12 * * ?").TimeUnits()
+	schedule := NewSchedule(append(units, tradingDaysUnit{})...)
+
+	iter := schedule.Upcoming(time.Date(2023, time.June, 2, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-06-02 12:00:00 +0000 UTC",
+		"2023-06-05 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestVerifyTimeUnit_acceptsBuiltInUnits(t *testing.T) {
+	from := time.Date(2023, time.June, 2, 0, 0, 0, 0, time.UTC)
+	until := from.AddDate(0, 0, 2)
+
+	for _, unit := range MustParse("0 */15 9-17 L-3-L * MON-FRI").TimeUnits() {
+		requireNoError(t, VerifyTimeUnit(unit, from, until))
+	}
+
+	requireNoError(t, VerifyTimeUnit(tradingDaysUnit{}, from, until))
+}
+
+func TestVerifyTimeUnit_abortsOnInconsistentUnits(t *testing.T) {
+	from := time.Date(2023, time.June, 2, 0, 0, 0, 0, time.UTC)
+	until := from.Add(3 * time.Hour)
+
+	requireErrorIs(t, VerifyTimeUnit(forwardsOnlyUnit{}, from, until), ErrInconsistentTimeUnit)
+	requireErrorIs(t, VerifyTimeUnit(skippingUnit{}, from, until), ErrInconsistentTimeUnit)
+}
@@ -0,0 +1,133 @@
+package gocron
+
+import (
+	"time"
+)
+
+// weekOfYearTimeUnit is a time unit implementation for the optional week of the
+// year field where the weeks are numbered according to ISO 8601.
+type weekOfYearTimeUnit []timeSet
+
+// Next implements TimeUnit.
+func (u weekOfYearTimeUnit) Next(next time.Time) (time.Time, bool) {
+	if len(u) == 0 {
+		return next, true
+	}
+
+	year, week := next.ISOWeek()
+
+	candidates := make([]int, 0, len(u))
+	for _, set := range u {
+		value, direction := set.NearestCandidate(next, week, true)
+
+		switch direction {
+		case hit:
+			return next, true
+		case inRange:
+			candidates = append(candidates, value)
+		}
+	}
+
+	// Move to the beginning of the earliest candidate week when it exists in
+	// the year, otherwise to the first week of the next year.
+	target := year + 1
+	targetWeek := 1
+	for _, candidate := range candidates {
+		if candidate <= weeksInISOYear(year) && (target > year || candidate < targetWeek) {
+			target, targetWeek = year, candidate
+		}
+	}
+
+	return startOfISOWeek(target, targetWeek, next.Location()), false
+}
+
+// Previous implements TimeUnit.
+func (u weekOfYearTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	if len(u) == 0 {
+		return before, true
+	}
+
+	year, week := before.ISOWeek()
+
+	candidates := make([]int, 0, len(u))
+	for _, set := range u {
+		value, direction := set.NearestCandidate(before, week, false)
+
+		switch direction {
+		case hit:
+			return before, true
+		case inRange:
+			candidates = append(candidates, value)
+		}
+	}
+
+	// Move to the end of the latest candidate week, otherwise to the end of the
+	// last week of the previous year.
+	targetWeek := 0
+	for _, candidate := range candidates {
+		targetWeek = max(targetWeek, candidate)
+	}
+
+	return startOfISOWeek(year, targetWeek+1, before.Location()).Add(-time.Second), false
+}
+
+// startOfISOWeek returns the beginning of the Monday of the week of the ISO
+// year. Weeks beyond the last week of the year continue into the next year.
+func startOfISOWeek(year, week int, loc *time.Location) time.Time {
+	// The 4th of January is always in the first week of the year.
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, loc)
+	offset := (int(jan4.Weekday()) + daysInWeek - 1) % daysInWeek
+
+	return time.Date(year, time.January, 4-offset+(week-1)*daysInWeek, 0, 0, 0, 0, loc)
+}
+
+// weeksInISOYear returns the number of weeks of the ISO year, either 52 or 53.
+func weeksInISOYear(year int) int {
+	// The 28th of December is always in the last week of the year.
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// weekEpoch is the beginning of the first ISO week of 1970 from which the steps
+// of the week of the year field are counted.
+var weekEpoch = startOfISOWeek(1970, 1, time.UTC)
+
+// weekIntervalExpr is an interval of weeks without an upper bound whose steps
+// are counted continuously from the first week of 1970 instead of restarting
+// every year, so that `*/2` is every other week even after a year of 53 weeks.
+// The weeks of the interval in 1970 are the same as a regular interval.
+type weekIntervalExpr struct {
+	intervalExpr
+}
+
+// continuousWeeks returns the sets of the week of the year field where the
+// intervals without an upper bound are counted continuously across the years.
+// A bounded interval (e.g. `1-10/3`) starts from its lower bound every year
+// like in the other fields.
+func continuousWeeks(sets []timeSet) []timeSet {
+	for i, set := range sets {
+		if interval, ok := set.(intervalExpr); ok && interval.open {
+			sets[i] = weekIntervalExpr{intervalExpr: interval}
+		}
+	}
+	return sets
+}
+
+func (i weekIntervalExpr) NearestCandidate(t time.Time, other int, forwards bool) (int, result) {
+	from, ok := i.rge.from.(unitExpr)
+	if !ok {
+		return i.intervalExpr.NearestCandidate(t, other, forwards)
+	}
+
+	// Shift the beginning of the interval by the number of weeks between the
+	// epoch and the first week of the year so that the steps continue.
+	year, _ := t.ISOWeek()
+	start := startOfISOWeek(year, 1, time.UTC)
+	offset := int(start.Sub(weekEpoch).Hours()) / (daysInWeek * 24)
+
+	shifted := intervalExpr{
+		rge:  rangeExpr{from: from + unitExpr(floorMod(-offset, i.incr)), to: i.rge.to},
+		incr: i.incr,
+	}
+	return shifted.NearestCandidate(t, other, forwards)
+}
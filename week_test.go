@@ -0,0 +1,117 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule_Upcoming_returnsEveryOtherWeek(t *testing.T) {
+	schedule, err := NewParser(WithWeekOfYear()).Parse("0 0 9 ? * MON */2")
+	requireNoError(t, err)
+
+	iter := schedule.Upcoming(time.Date(2025, time.December, 14, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2025-12-15 09:00:00 +0000 UTC",
+		"2025-12-29 09:00:00 +0000 UTC",
+		"2026-01-12 09:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Preceding_returnsEveryOtherWeek(t *testing.T) {
+	schedule, err := NewParser(WithWeekOfYear()).Parse("0 0 9 ? * MON */2")
+	requireNoError(t, err)
+
+	iter := schedule.Preceding(time.Date(2026, time.January, 13, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2026-01-12 09:00:00 +0000 UTC",
+		"2025-12-29 09:00:00 +0000 UTC",
+		"2025-12-15 09:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Upcoming_returnsEveryOtherWeekAfterWeek53(t *testing.T) {
+	schedule, err := NewParser(WithWeekOfYear()).Parse("0 0 9 ? * MON */2")
+	requireNoError(t, err)
+
+	iter := schedule.Upcoming(time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2026-12-14 09:00:00 +0000 UTC",
+		"2026-12-28 09:00:00 +0000 UTC",
+		"2027-01-11 09:00:00 +0000 UTC",
+		"2027-01-25 09:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+
+	iter = schedule.Preceding(time.Date(2027, time.January, 12, 0, 0, 0, 0, time.UTC))
+	expects = []string{
+		"2027-01-11 09:00:00 +0000 UTC",
+		"2026-12-28 09:00:00 +0000 UTC",
+		"2026-12-14 09:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Upcoming_returnsWeeksOfBoundedInterval(t *testing.T) {
+	schedule, err := NewParser(WithWeekOfYear()).Parse("0 0 0 ? * MON 1-10/3")
+	requireNoError(t, err)
+
+	// Steps of a bounded interval start from its lower bound every year, even
+	// after the 53 weeks of 2026.
+	iter := schedule.Upcoming(time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2027-01-04 00:00:00 +0000 UTC",
+		"2027-01-25 00:00:00 +0000 UTC",
+		"2027-02-15 00:00:00 +0000 UTC",
+		"2027-03-08 00:00:00 +0000 UTC",
+		"2028-01-03 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Upcoming_returnsWeek53(t *testing.T) {
+	schedule, err := NewParser(WithWeekOfYear()).Parse("0 0 9 ? * MON 53")
+	requireNoError(t, err)
+
+	iter := schedule.Upcoming(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2026-12-28 09:00:00 +0000 UTC",
+		"2032-12-27 09:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+
+	iter = schedule.Preceding(time.Date(2032, time.December, 27, 0, 0, 0, 0, time.UTC))
+	expects = []string{
+		"2026-12-28 09:00:00 +0000 UTC",
+		"2020-12-28 09:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Upcoming_returnsWeekWithYear(t *testing.T) {
+	schedule, err := NewParser(WithWeekOfYear()).Parse("0 0 0 * * ? 10 2024")
+	requireNoError(t, err)
+
+	next := schedule.Next(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if !next.Equal(time.Date(2024, time.March, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected activation %v", next)
+	}
+}
+
+func TestParser_Parse_abortsOnMalformedWeeksOfYear(t *testing.T) {
+	parser := NewParser(WithWeekOfYear())
+
+	_, err := parser.Parse("0 0 0 * * ? 54")
+	requireErrorIs(t, err, newTimeUnitErr(WeeksOfYear, ErrValueOutsideRange))
+
+	_, err = parser.Parse("0 0 0 * * ?")
+	requireErrorIs(t, err, ErrMalformedExpression)
+}
@@ -0,0 +1,80 @@
+package gocron
+
+import (
+	"slices"
+	"time"
+)
+
+// Starting returns a copy of the schedule which does not activate before the
+// given time. The time is rounded up to the next second.
+func (s Schedule) Starting(start time.Time) Schedule {
+	if rounded := start.Truncate(time.Second); !rounded.Equal(start) {
+		start = rounded.Add(time.Second)
+	}
+	return s.withWindow(windowTimeUnit{start: start})
+}
+
+// Until returns a copy of the schedule which does not activate after the given
+// time, which is included.
+func (s Schedule) Until(end time.Time) Schedule {
+	return s.withWindow(windowTimeUnit{end: end.Truncate(time.Second)})
+}
+
+// withWindow returns a copy of the schedule where the window is evaluated
+// first so that the other time units are not evaluated outside of it.
+func (s Schedule) withWindow(window windowTimeUnit) Schedule {
+	return Schedule{timeUnits: append([]TimeUnit{window}, slices.Clone(s.timeUnits)...)}
+}
+
+// Limit returns a copy of the schedule which activates at most `n` times,
+// starting at the first activation at or after the anchor. The limit is kept
+// when the schedule is exported as a recurrence rule.
+//
+// The activations are iterated when the schedule is built to find the last
+// one, so the cost grows with `n` and with the gaps between the activations
+// (e.g. `L-31` or leap days only).
+func (s Schedule) Limit(anchor time.Time, n int) Schedule {
+	window := windowTimeUnit{start: anchor.Truncate(time.Second)}
+	if !window.start.Equal(anchor) {
+		window.start = window.start.Add(time.Second)
+	}
+
+	// The window ends with the last activation allowed by the limit, or before
+	// it starts when no activation is allowed.
+	window.end = window.start.Add(-time.Second)
+
+	iter := s.Upcoming(window.start.Add(-time.Second))
+	for ; window.count < n && iter.HasNext(); window.count++ {
+		window.end = iter.Next()
+	}
+
+	return s.withWindow(window)
+}
+
+// window returns the intersection of the windows of the schedule where a zero
+// time means that the window is open. The count is kept only when the window
+// with the count is the intersection.
+func (s Schedule) window() (window windowTimeUnit) {
+	var windows []windowTimeUnit
+	for _, unit := range s.timeUnits {
+		if w, ok := unit.(windowTimeUnit); ok {
+			windows = append(windows, w)
+		}
+	}
+
+	for _, w := range windows {
+		if window.start.IsZero() || w.start.After(window.start) {
+			window.start = w.start
+		}
+		if window.end.IsZero() || (!w.end.IsZero() && w.end.Before(window.end)) {
+			window.end = w.end
+		}
+	}
+
+	for _, w := range windows {
+		if w.count > 0 && w.start.Equal(window.start) && w.end.Equal(window.end) {
+			window.count = w.count
+		}
+	}
+	return
+}
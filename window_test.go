@@ -0,0 +1,168 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule_Starting_skipsActivationsBefore(t *testing.T) {
+	schedule := MustParse("0 0 * * * ?").Starting(time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC))
+
+	iter := schedule.Upcoming(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2026-11-01 00:00:00 +0000 UTC",
+		"2026-11-01 01:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+
+	prev := schedule.Previous(time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC))
+	if !prev.IsZero() {
+		t.Fatalf("unexpected activation %v", prev)
+	}
+}
+
+func TestSchedule_Starting_roundsUpToSecond(t *testing.T) {
+	schedule := MustParse("* * * * * ?").Starting(time.Date(2026, time.November, 1, 0, 0, 0, 500, time.UTC))
+
+	next := schedule.Next(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC))
+	if next != time.Date(2026, time.November, 1, 0, 0, 1, 0, time.UTC) {
+		t.Fatalf("unexpected activation %v", next)
+	}
+}
+
+func TestSchedule_Until_stopsIterator(t *testing.T) {
+	schedule := MustParse("0 0 * * * ?").
+		Starting(time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)).
+		Until(time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC))
+
+	iter := schedule.Upcoming(time.Date(2026, time.December, 30, 22, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2026-12-30 23:00:00 +0000 UTC",
+		"2026-12-31 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+
+	if iter.HasNext() {
+		t.Fatalf("unexpected activation %v", iter.Next())
+	}
+
+	iter = schedule.Preceding(time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if next := iter.Next(); next != time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected activation %v", next)
+	}
+}
+
+func TestSchedule_ToRRule_usesWindowEnd(t *testing.T) {
+	schedule := MustParse("0 0 12 * * ?").Until(time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC))
+
+	rule, err := schedule.ToRRule(time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC), time.Time{})
+	requireNoError(t, err)
+
+	expect := "DTSTART:20261101T120000Z\nRRULE:FREQ=DAILY;BYHOUR=12;BYMINUTE=0;BYSECOND=0;UNTIL=20261231T000000Z"
+	if rule != expect {
+		t.Fatalf("%q != %q", rule, expect)
+	}
+}
+
+func TestSchedule_Limit_returnsExactNumberOfActivations(t *testing.T) {
+	schedule := MustParse("0 0 12 * * ?").Limit(time.Date(2026, time.November, 1, 12, 0, 0, 0, time.UTC), 3)
+
+	iter := schedule.Upcoming(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2026-11-01 12:00:00 +0000 UTC",
+		"2026-11-02 12:00:00 +0000 UTC",
+		"2026-11-03 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+
+	if iter.HasNext() {
+		t.Fatalf("unexpected activation %v", iter.Next())
+	}
+
+	iter = schedule.Preceding(time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC))
+	expects = []string{
+		"2026-11-03 12:00:00 +0000 UTC",
+		"2026-11-02 12:00:00 +0000 UTC",
+		"2026-11-01 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+
+	if iter.HasNext() {
+		t.Fatalf("unexpected activation %v", iter.Next())
+	}
+}
+
+func TestSchedule_Limit_returnsNothingWithoutActivations(t *testing.T) {
+	schedule := MustParse("0 0 12 * * ?").Limit(time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC), 0)
+
+	if next := schedule.Next(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)); !next.IsZero() {
+		t.Fatalf("unexpected activation %v", next)
+	}
+}
+
+func TestSchedule_Limit_survivesRecurrenceRule(t *testing.T) {
+	anchor := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	schedule := MustParse("0 0 12 * * ?").Limit(anchor, 10)
+
+	rule, err := schedule.ToRRule(anchor, time.Time{})
+	requireNoError(t, err)
+
+	expect := "DTSTART:20261101T120000Z\nRRULE:FREQ=DAILY;BYHOUR=12;BYMINUTE=0;BYSECOND=0;COUNT=10"
+	if rule != expect {
+		t.Fatalf("%q != %q", rule, expect)
+	}
+
+	parsed, err := ParseRRule(rule)
+	requireNoError(t, err)
+
+	a := schedule.Upcoming(anchor)
+	b := parsed.Upcoming(anchor)
+	for a.HasNext() || b.HasNext() {
+		if next := a.Next(); !next.Equal(b.Next()) {
+			t.Fatalf("activation %v differs", next)
+		}
+	}
+
+	// The count is replaced by the end when the rule starts later.
+	rule, err = schedule.ToRRule(anchor.AddDate(0, 0, 5), time.Time{})
+	requireNoError(t, err)
+
+	expect = "DTSTART:20261106T120000Z\nRRULE:FREQ=DAILY;BYHOUR=12;BYMINUTE=0;BYSECOND=0;UNTIL=20261110T120000Z"
+	if rule != expect {
+		t.Fatalf("%q != %q", rule, expect)
+	}
+}
+
+func TestSchedule_Limit_survivesListOfDates(t *testing.T) {
+	anchor := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	located, err := ParseSystemdCalendar("*-*-* 12:00:00 Europe/Zurich")
+	requireNoError(t, err)
+	schedule := located.Limit(anchor, 3)
+
+	// A schedule with a location is not expressible as a rule, so the limited
+	// activations are listed.
+	rule, err := schedule.ToRRule(anchor, time.Time{})
+	requireNoError(t, err)
+
+	expect := "DTSTART;TZID=Europe/Zurich:20261101T120000\n" +
+		"RDATE;TZID=Europe/Zurich:20261101T120000,20261102T120000,20261103T120000"
+	if rule != expect {
+		t.Fatalf("%q != %q", rule, expect)
+	}
+
+	parsed, err := ParseRRule(rule)
+	requireNoError(t, err)
+
+	a := schedule.Upcoming(anchor)
+	b := parsed.Upcoming(anchor)
+	for a.HasNext() || b.HasNext() {
+		if next := a.Next(); !next.Equal(b.Next()) {
+			t.Fatalf("activation %v differs", next)
+		}
+	}
+}
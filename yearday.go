@@ -0,0 +1,103 @@
+package gocron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dayOfYearTimeUnit is a time unit implementation for the optional day of the
+// year field.
+type dayOfYearTimeUnit []timeSet
+
+// Next implements TimeUnit.
+func (u dayOfYearTimeUnit) Next(next time.Time) (time.Time, bool) {
+	if len(u) == 0 {
+		return next, true
+	}
+
+	candidates := make([]int, 0, len(u))
+	for _, set := range u {
+		value, direction := set.NearestCandidate(next, next.YearDay(), true)
+
+		switch direction {
+		case hit:
+			return next, true
+		case inRange:
+			candidates = append(candidates, value)
+		}
+	}
+
+	// Move to the beginning of the earliest candidate day when it exists in the
+	// year (e.g. 366 for leap years only), otherwise to the next year.
+	day := daysInYear(next.Year()) + 1
+	for _, candidate := range candidates {
+		day = min(day, candidate)
+	}
+
+	return time.Date(next.Year(), time.January, day, 0, 0, 0, 0, next.Location()), false
+}
+
+// Previous implements TimeUnit.
+func (u dayOfYearTimeUnit) Previous(before time.Time) (time.Time, bool) {
+	if len(u) == 0 {
+		return before, true
+	}
+
+	candidates := make([]int, 0, len(u))
+	for _, set := range u {
+		value, direction := set.NearestCandidate(before, before.YearDay(), false)
+
+		switch direction {
+		case hit:
+			return before, true
+		case inRange:
+			candidates = append(candidates, value)
+		}
+	}
+
+	// Move to the end of the latest candidate day, otherwise to the end of the
+	// previous year.
+	day := 0
+	for _, candidate := range candidates {
+		day = max(day, candidate)
+	}
+
+	return time.Date(before.Year(), time.January, day+1, 0, 0, 0, 0, before.Location()).Add(-time.Second), false
+}
+
+// daysInYear returns the number of days in the year, either 365 or 366.
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// convertWithLastDayOfYear returns the day of the year which supports the last
+// day of the year (`L`) and the nth last day (e.g. `L-2` for the second last
+// day), like the days of the month.
+func convertWithLastDayOfYear(value string) (timeSet, error) {
+	if value == "L" {
+		return nthLastDayOfYearExpr{}, nil
+	}
+	if offset, found := strings.CutPrefix(value, "L-"); found {
+		nth, err := strconv.Atoi(offset)
+		return nthLastDayOfYearExpr{nthLast: nth - 1}, err
+	}
+	return convertUnit(value)
+}
+
+// nthLastDayOfYearExpr is a specialized expression field to determine the nth
+// last day of the year, where zero is the last day, which depends on leap
+// years.
+type nthLastDayOfYearExpr struct {
+	nthLast int
+}
+
+func (e nthLastDayOfYearExpr) NearestCandidate(t time.Time, other int, forwards bool) (int, result) {
+	return unitExpr(daysInYear(t.Year())-e.nthLast).NearestCandidate(t, other, forwards)
+}
+
+func (e nthLastDayOfYearExpr) SubsetOf(min, max int) bool {
+	// The day must exist in years which are not leap years, which have one day
+	// less than the range.
+	return e.nthLast >= 0 && e.nthLast < max-min
+}
@@ -0,0 +1,94 @@
+package gocron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchedule_Upcoming_returnsDayOfYear(t *testing.T) {
+	schedule, err := NewParser(WithDayOfYear()).Parse("0 0 0 * * ? 100")
+	requireNoError(t, err)
+
+	iter := schedule.Upcoming(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-04-10 00:00:00 +0000 UTC",
+		"2024-04-09 00:00:00 +0000 UTC",
+		"2025-04-10 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+
+	iter = schedule.Preceding(time.Date(2025, time.April, 10, 0, 0, 0, 0, time.UTC))
+	expects = []string{
+		"2024-04-09 00:00:00 +0000 UTC",
+		"2023-04-10 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Upcoming_returnsIntervalOfDaysOfYear(t *testing.T) {
+	schedule, err := NewParser(WithDayOfYear()).Parse("0 0 0 * * ? */30")
+	requireNoError(t, err)
+
+	iter := schedule.Upcoming(time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2023-12-27 00:00:00 +0000 UTC",
+		"2024-01-01 00:00:00 +0000 UTC",
+		"2024-01-31 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Preceding_returnsLastDaysOfYear(t *testing.T) {
+	schedule, err := NewParser(WithDayOfYear()).Parse("0 0 12 * * ? 366,L-2")
+	requireNoError(t, err)
+
+	iter := schedule.Preceding(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2024-12-31 12:00:00 +0000 UTC",
+		"2024-12-30 12:00:00 +0000 UTC",
+		"2023-12-30 12:00:00 +0000 UTC",
+		"2022-12-30 12:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}
+
+func TestSchedule_Upcoming_returnsDayOfYearWithWeekOfYear(t *testing.T) {
+	schedule, err := NewParser(WithWeekOfYear(), WithDayOfYear()).Parse("0 0 0 * * ? 1 L 2025")
+	requireNoError(t, err)
+
+	// The 31st of December 2025 is in the first week of 2026.
+	next := schedule.Next(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if next != time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("unexpected activation %v", next)
+	}
+}
+
+func TestParser_Parse_abortsOnMalformedDaysOfYear(t *testing.T) {
+	parser := NewParser(WithDayOfYear())
+
+	_, err := parser.Parse("0 0 0 * * ? 367")
+	requireErrorIs(t, err, newTimeUnitErr(DaysOfYear, ErrValueOutsideRange))
+
+	_, err = parser.Parse("0 0 0 * * ? L-367")
+	requireErrorIs(t, err, newTimeUnitErr(DaysOfYear, ErrValueOutsideRange))
+
+	_, err = parser.Parse("0 0 0 * * ? L-366")
+	requireErrorIs(t, err, newTimeUnitErr(DaysOfYear, ErrValueOutsideRange))
+}
+
+func TestSchedule_Upcoming_returnsFirstDayAsLastDaysOfYear(t *testing.T) {
+	schedule, err := NewParser(WithDayOfYear()).Parse("0 0 0 * * ? L-365")
+	requireNoError(t, err)
+
+	iter := schedule.Upcoming(time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC))
+	expects := []string{
+		"2024-01-02 00:00:00 +0000 UTC",
+		"2025-01-01 00:00:00 +0000 UTC",
+	}
+
+	testIterator(t, iter, expects)
+}